@@ -1,7 +1,10 @@
 package domain
 
 import (
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
 	user "github.com/hub1989/keycloak-protobuf/golang/keycloak"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
 )
 
 type AccessTokenResponse struct {
@@ -32,3 +35,51 @@ func (r AccessTokenResponse) AccessTokenToGRpcResponse() user.AccessTokenRespons
 		Scope:            r.Scope,
 	}
 }
+
+// TokenClaims are the verified claims of a Keycloak-issued access token.
+type TokenClaims struct {
+	Subject           string
+	Issuer            string
+	Audience          []string
+	AuthorizedParty   string
+	ExpiresAt         time.Time
+	IssuedAt          time.Time
+	SessionId         string
+	Scopes            []string
+	PreferredUsername string
+	Email             string
+	RealmRoles        []string
+	ClientRoles       map[string][]string
+}
+
+// TokenClaimsToGRpcResponse describes an active token.
+func (c TokenClaims) TokenClaimsToGRpcResponse() *keycloakext.ValidateTokenResponse {
+	response := &keycloakext.ValidateTokenResponse{
+		Active:          true,
+		Subject:         c.Subject,
+		RealmRoles:      c.RealmRoles,
+		Scopes:          c.Scopes,
+		SessionId:       c.SessionId,
+		Issuer:          c.Issuer,
+		Audience:        c.Audience,
+		AuthorizedParty: c.AuthorizedParty,
+		Username:        c.PreferredUsername,
+		Email:           c.Email,
+	}
+
+	if !c.ExpiresAt.IsZero() {
+		response.ExpiresAt = timestamppb.New(c.ExpiresAt)
+	}
+	if !c.IssuedAt.IsZero() {
+		response.IssuedAt = timestamppb.New(c.IssuedAt)
+	}
+
+	if len(c.ClientRoles) > 0 {
+		response.ClientRoles = make(map[string]*keycloakext.RoleNames, len(c.ClientRoles))
+		for clientId, roles := range c.ClientRoles {
+			response.ClientRoles[clientId] = &keycloakext.RoleNames{Names: roles}
+		}
+	}
+
+	return response
+}
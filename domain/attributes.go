@@ -0,0 +1,39 @@
+package domain
+
+import "github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
+
+func AttributesToGRpc(attributes map[string][]string) map[string]*keycloakext.AttributeValues {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	response := make(map[string]*keycloakext.AttributeValues, len(attributes))
+	for key, values := range attributes {
+		response[key] = &keycloakext.AttributeValues{Values: values}
+	}
+	return response
+}
+
+func AttributesGRpcRequestToAttributes(request map[string]*keycloakext.AttributeValues) map[string][]string {
+	if len(request) == 0 {
+		return nil
+	}
+
+	attributes := make(map[string][]string, len(request))
+	for key, value := range request {
+		attributes[key] = value.GetValues()
+	}
+	return attributes
+}
+
+// FirstAttributeValues flattens attributes for the single valued maps of the
+// keycloak protobuf API, keeping the first value of each attribute.
+func FirstAttributeValues(attributes map[string][]string) map[string]string {
+	values := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		if len(value) > 0 {
+			values[key] = value[0]
+		}
+	}
+	return values
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"encoding/json"
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
+	"reflect"
+	"testing"
+)
+
+// attributeCases are the shapes of values the conversions must pass through unchanged.
+var attributeCases = []struct {
+	name       string
+	attributes map[string][]string
+}{
+	{"single value", map[string][]string{"locale": {"en"}}},
+	{"spaces", map[string][]string{"address": {"1 Main Street, Springfield"}}},
+	{"brackets", map[string][]string{"note": {"[draft] (v2) {x}"}}},
+	{"commas", map[string][]string{"tags": {"a,b,c"}}},
+	{"multiple values", map[string][]string{"roles": {"admin", "user [read]", "a, b"}}},
+	{"several attributes", map[string][]string{"locale": {"de"}, "phoneNumber": {"+49 30 1234"}}},
+}
+
+func TestFirstAttributeValues(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string][]string
+		want       map[string]string
+	}{
+		{"nil", nil, map[string]string{}},
+		{"single value", map[string][]string{"locale": {"en"}}, map[string]string{"locale": "en"}},
+		{"spaces", map[string][]string{"address": {"1 Main Street"}}, map[string]string{"address": "1 Main Street"}},
+		{"brackets", map[string][]string{"note": {"[draft]"}}, map[string]string{"note": "[draft]"}},
+		{"commas", map[string][]string{"tags": {"a,b,c"}}, map[string]string{"tags": "a,b,c"}},
+		{"multiple values", map[string][]string{"roles": {"admin", "user"}}, map[string]string{"roles": "admin"}},
+		{"no values", map[string][]string{"empty": {}}, map[string]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FirstAttributeValues(tt.attributes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FirstAttributeValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAttributesRoundTrip(t *testing.T) {
+	for _, tt := range attributeCases {
+		t.Run(tt.name, func(t *testing.T) {
+			grpcAttributes := AttributesToGRpc(tt.attributes)
+			for key, values := range tt.attributes {
+				if got := grpcAttributes[key].GetValues(); !reflect.DeepEqual(got, values) {
+					t.Errorf("AttributesToGRpc()[%q] = %q, want %q", key, got, values)
+				}
+			}
+
+			if got := AttributesGRpcRequestToAttributes(grpcAttributes); !reflect.DeepEqual(got, tt.attributes) {
+				t.Errorf("AttributesGRpcRequestToAttributes() = %q, want %q", got, tt.attributes)
+			}
+		})
+	}
+}
+
+func TestAttributesEmpty(t *testing.T) {
+	if got := AttributesToGRpc(map[string][]string{}); got != nil {
+		t.Errorf("AttributesToGRpc() = %v, want nil", got)
+	}
+	if got := AttributesGRpcRequestToAttributes(map[string]*keycloakext.AttributeValues{}); got != nil {
+		t.Errorf("AttributesGRpcRequestToAttributes() = %v, want nil", got)
+	}
+	if got := AttributesGRpcRequestToAttributes(map[string]*keycloakext.AttributeValues{"cleared": nil}); !reflect.DeepEqual(got, map[string][]string{"cleared": nil}) {
+		t.Errorf("AttributesGRpcRequestToAttributes() = %v, want an empty cleared attribute", got)
+	}
+}
+
+func TestUserAttributesJSON(t *testing.T) {
+	for _, tt := range attributeCases {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(map[string]interface{}{"id": "1", "attributes": tt.attributes})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var user UserRepresentation
+			if err = json.Unmarshal(data, &user); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(user.Attributes, tt.attributes) {
+				t.Errorf("decoded attributes = %q, want %q", user.Attributes, tt.attributes)
+			}
+		})
+	}
+}
@@ -1,34 +1,32 @@
 package domain
 
 import (
-	"fmt"
 	user "github.com/hub1989/keycloak-protobuf/golang/keycloak"
-	"strings"
 )
 
 type Client struct {
-	Id                                 string                 `json:"id"`
-	ClientId                           string                 `json:"clientId"`
-	Name                               string                 `json:"name"`
-	RootUrl                            string                 `json:"rootUrl"`
-	BaseUrl                            string                 `json:"baseUrl"`
-	SurrogateAuthRequired              bool                   `json:"surrogateAuthRequired"`
-	Enabled                            bool                   `json:"enabled"`
-	AlwaysDisplayInConsole             bool                   `json:"alwaysDisplayInConsole"`
-	ClientAuthenticatorType            string                 `json:"clientAuthenticatorType"`
-	RedirectUris                       []string               `json:"redirectUris"`
-	WebOrigins                         []interface{}          `json:"webOrigins"`
-	NotBefore                          int                    `json:"notBefore"`
-	BearerOnly                         bool                   `json:"bearerOnly"`
-	ConsentRequired                    bool                   `json:"consentRequired"`
-	StandardFlowEnabled                bool                   `json:"standardFlowEnabled"`
-	ImplicitFlowEnabled                bool                   `json:"implicitFlowEnabled"`
-	DirectAccessGrantsEnabled          bool                   `json:"directAccessGrantsEnabled"`
-	ServiceAccountsEnabled             bool                   `json:"serviceAccountsEnabled"`
-	PublicClient                       bool                   `json:"publicClient"`
-	FrontchannelLogout                 bool                   `json:"frontchannelLogout"`
-	Protocol                           string                 `json:"protocol"`
-	Attributes                         map[string]interface{} `json:"attributes"`
+	Id                                 string            `json:"id"`
+	ClientId                           string            `json:"clientId"`
+	Name                               string            `json:"name"`
+	RootUrl                            string            `json:"rootUrl"`
+	BaseUrl                            string            `json:"baseUrl"`
+	SurrogateAuthRequired              bool              `json:"surrogateAuthRequired"`
+	Enabled                            bool              `json:"enabled"`
+	AlwaysDisplayInConsole             bool              `json:"alwaysDisplayInConsole"`
+	ClientAuthenticatorType            string            `json:"clientAuthenticatorType"`
+	RedirectUris                       []string          `json:"redirectUris"`
+	WebOrigins                         []interface{}     `json:"webOrigins"`
+	NotBefore                          int               `json:"notBefore"`
+	BearerOnly                         bool              `json:"bearerOnly"`
+	ConsentRequired                    bool              `json:"consentRequired"`
+	StandardFlowEnabled                bool              `json:"standardFlowEnabled"`
+	ImplicitFlowEnabled                bool              `json:"implicitFlowEnabled"`
+	DirectAccessGrantsEnabled          bool              `json:"directAccessGrantsEnabled"`
+	ServiceAccountsEnabled             bool              `json:"serviceAccountsEnabled"`
+	PublicClient                       bool              `json:"publicClient"`
+	FrontchannelLogout                 bool              `json:"frontchannelLogout"`
+	Protocol                           string            `json:"protocol"`
+	Attributes                         map[string]string `json:"attributes"`
 	AuthenticationFlowBindingOverrides struct {
 	} `json:"authenticationFlowBindingOverrides"`
 	FullScopeAllowed          bool     `json:"fullScopeAllowed"`
@@ -39,16 +37,6 @@ type Client struct {
 }
 
 func (c Client) ClientToGRpcResponse() user.ClientResponse {
-
-	attributes := make(map[string]string)
-	for key, value := range c.Attributes {
-		x := fmt.Sprintf("%s", value)
-		x = strings.ReplaceAll(x, "[", "")
-		x = strings.ReplaceAll(x, "]", "")
-
-		attributes[key] = x
-	}
-
 	return user.ClientResponse{
 		Id:         c.Id,
 		ClientId:   c.ClientId,
@@ -56,6 +44,6 @@ func (c Client) ClientToGRpcResponse() user.ClientResponse {
 		RootUrl:    c.RootUrl,
 		WebUrl:     c.BaseUrl,
 		Enabled:    c.Enabled,
-		Attributes: attributes,
+		Attributes: c.Attributes,
 	}
 }
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestClientAttributesJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want map[string]string
+	}{
+		{"none", `{"clientId":"app"}`, nil},
+		{"plain", `{"attributes":{"pkce.code.challenge.method":"S256"}}`, map[string]string{"pkce.code.challenge.method": "S256"}},
+		{"spaces", `{"attributes":{"display.on.consent.screen":"My App Name"}}`, map[string]string{"display.on.consent.screen": "My App Name"}},
+		{"brackets", `{"attributes":{"note":"[beta] (internal)"}}`, map[string]string{"note": "[beta] (internal)"}},
+		{"commas", `{"attributes":{"acr.loa.map":"{\"gold\":\"3\",\"silver\":\"2\"}"}}`, map[string]string{"acr.loa.map": `{"gold":"3","silver":"2"}`}},
+		// Keycloak joins multiple values of a client attribute with ##
+		{"multiple values", `{"attributes":{"post.logout.redirect.uris":"https://a.example/##https://b.example/"}}`, map[string]string{"post.logout.redirect.uris": "https://a.example/##https://b.example/"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var client Client
+			if err := json.Unmarshal([]byte(tt.json), &client); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(client.Attributes, tt.want) {
+				t.Errorf("Attributes = %q, want %q", client.Attributes, tt.want)
+			}
+
+			response := client.ClientToGRpcResponse()
+			if !reflect.DeepEqual(response.Attributes, tt.want) {
+				t.Errorf("ClientToGRpcResponse().Attributes = %q, want %q", response.Attributes, tt.want)
+			}
+		})
+	}
+}
@@ -13,11 +13,11 @@ type GroupOverview struct {
 }
 
 type Group struct {
-	Id          string            `json:"id,omitempty"`
-	Name        string            `json:"name"`
-	Path        string            `json:"path"`
-	Attributes  map[string]string `json:"attributes"`
-	RealmRoles  []string          `json:"realmRoles"`
+	Id          string              `json:"id,omitempty"`
+	Name        string              `json:"name"`
+	Path        string              `json:"path"`
+	Attributes  map[string][]string `json:"attributes"`
+	RealmRoles  []string            `json:"realmRoles"`
 	ClientRoles struct {
 	} `json:"clientRoles"`
 	SubGroups []interface{} `json:"subGroups"`
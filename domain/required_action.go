@@ -0,0 +1,52 @@
+package domain
+
+import "github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
+
+// RequiredAction is a required action provider registered in the realm, e.g.
+// VERIFY_EMAIL or UPDATE_PASSWORD.
+type RequiredAction struct {
+	Alias         string            `json:"alias"`
+	Name          string            `json:"name"`
+	ProviderId    string            `json:"providerId"`
+	Enabled       bool              `json:"enabled"`
+	DefaultAction bool              `json:"defaultAction"`
+	Priority      int               `json:"priority"`
+	Config        map[string]string `json:"config,omitempty"`
+}
+
+// ActionsEmail holds the optional parameters of the execute-actions and
+// verify emails. RedirectUri is only honoured together with ClientId.
+type ActionsEmail struct {
+	ClientId    string
+	RedirectUri string
+	// Lifespan is how long the link stays valid in seconds, zero keeps the realm default.
+	Lifespan int
+}
+
+func (a RequiredAction) RequiredActionToGRpcResponse() *keycloakext.RequiredAction {
+	return &keycloakext.RequiredAction{
+		Alias:         a.Alias,
+		Name:          a.Name,
+		Enabled:       a.Enabled,
+		DefaultAction: a.DefaultAction,
+		Priority:      int32(a.Priority),
+	}
+}
+
+// UnknownRequiredActions returns the actions that are not enabled in the realm.
+func UnknownRequiredActions(actions []string, realmActions []RequiredAction) []string {
+	enabled := make(map[string]bool, len(realmActions))
+	for _, action := range realmActions {
+		if action.Enabled {
+			enabled[action.Alias] = true
+		}
+	}
+
+	var unknown []string
+	for _, action := range actions {
+		if !enabled[action] {
+			unknown = append(unknown, action)
+		}
+	}
+	return unknown
+}
@@ -1,17 +1,56 @@
 package domain
 
-import user "github.com/hub1989/keycloak-protobuf/golang/keycloak"
+import (
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
+	user "github.com/hub1989/keycloak-protobuf/golang/keycloak"
+)
 
 type Role struct {
-	Id                 string      `json:"id"`
-	Name               string      `json:"name"`
-	Description        string      `json:"description"`
-	ScopeParamRequired interface{} `json:"scopeParamRequired"`
-	Composite          bool        `json:"composite"`
-	Composites         interface{} `json:"composites"`
-	ClientRole         bool        `json:"clientRole"`
-	ContainerId        string      `json:"containerId"`
-	Attributes         interface{} `json:"attributes"`
+	Id                 string              `json:"id"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description"`
+	ScopeParamRequired interface{}         `json:"scopeParamRequired"`
+	Composite          bool                `json:"composite"`
+	Composites         *RoleComposites     `json:"composites,omitempty"`
+	ClientRole         bool                `json:"clientRole"`
+	ContainerId        string              `json:"containerId"`
+	Attributes         map[string][]string `json:"attributes"`
+}
+
+// RoleComposites names the children of a composite role.
+type RoleComposites struct {
+	Realm []string `json:"realm,omitempty"`
+	// Client maps a clientId to the names of its roles.
+	Client map[string][]string `json:"client,omitempty"`
+}
+
+// EffectiveRoles are the roles a user holds directly, through composite roles and through groups.
+type EffectiveRoles struct {
+	Realm []Role
+	// Client maps a clientId to the user's roles of that client.
+	Client map[string][]Role
+}
+
+// RoleMappings are the roles mapped directly to a user or group.
+type RoleMappings struct {
+	RealmMappings []Role `json:"realmMappings"`
+	// ClientMappings is keyed by clientId.
+	ClientMappings map[string]ClientRoleMappings `json:"clientMappings"`
+}
+
+type ClientRoleMappings struct {
+	Id       string `json:"id"`
+	Client   string `json:"client"`
+	Mappings []Role `json:"mappings"`
+}
+
+type RoleSearch struct {
+	// Search matches the role name by substring.
+	Search string
+	// Brief leaves out the attributes.
+	Brief bool
+	First int
+	Max   int
 }
 
 func (r Role) RoleToGRpcResponse() user.RoleResponse {
@@ -20,3 +59,43 @@ func (r Role) RoleToGRpcResponse() user.RoleResponse {
 		Name: r.Name,
 	}
 }
+
+func (r Role) RoleToGRpcRole() *keycloakext.Role {
+	return &keycloakext.Role{
+		Id:          r.Id,
+		Name:        r.Name,
+		Description: r.Description,
+		Composite:   r.Composite,
+		ClientRole:  r.ClientRole,
+		ContainerId: r.ContainerId,
+		Attributes:  AttributesToGRpc(r.Attributes),
+	}
+}
+
+func RoleGRpcRequestToRole(request *keycloakext.Role) Role {
+	return Role{
+		Name:        request.Name,
+		Description: request.Description,
+		Attributes:  AttributesGRpcRequestToAttributes(request.Attributes),
+	}
+}
+
+// UpdateRole applies the set fields of the request. Attributes are merged,
+// and an attribute sent without values is removed.
+func (r *Role) UpdateRole(request *keycloakext.UpdateRoleRequest) {
+	if request.Description != nil {
+		r.Description = request.Description.Value
+	}
+
+	if r.Attributes == nil {
+		// an empty map, unlike nil, makes keycloak replace the stored attributes
+		r.Attributes = map[string][]string{}
+	}
+	for key, value := range request.Attributes {
+		if len(value.GetValues()) == 0 {
+			delete(r.Attributes, key)
+			continue
+		}
+		r.Attributes[key] = value.GetValues()
+	}
+}
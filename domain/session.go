@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"time"
+)
+
+// OfflineTokenGrant is the key of the additional consent grant Keycloak
+// reports for clients holding an offline token.
+const OfflineTokenGrant = "Offline Token"
+
+type UserSession struct {
+	Id         string `json:"id"`
+	Username   string `json:"username"`
+	UserId     string `json:"userId"`
+	IpAddress  string `json:"ipAddress"`
+	Start      int64  `json:"start"`
+	LastAccess int64  `json:"lastAccess"`
+	RememberMe bool   `json:"rememberMe"`
+	// Clients maps the id of each client in the session to its clientId.
+	Clients map[string]string `json:"clients"`
+	Offline bool              `json:"-"`
+}
+
+// UserConsent is a client the user granted scopes or holds an offline token for.
+type UserConsent struct {
+	ClientId            string         `json:"clientId"`
+	GrantedClientScopes []string       `json:"grantedClientScopes"`
+	CreatedDate         int64          `json:"createdDate"`
+	LastUpdatedDate     int64          `json:"lastUpdatedDate"`
+	AdditionalGrants    []ConsentGrant `json:"additionalGrants"`
+}
+
+type ConsentGrant struct {
+	// Client is the id of the client.
+	Client string `json:"client"`
+	Key    string `json:"key"`
+}
+
+// OfflineClient returns the id of the client when the consent includes an offline token.
+func (c UserConsent) OfflineClient() (string, bool) {
+	for _, grant := range c.AdditionalGrants {
+		if grant.Key == OfflineTokenGrant {
+			return grant.Client, true
+		}
+	}
+	return "", false
+}
+
+func (s UserSession) UserSessionToGRpcResponse() *keycloakext.UserSession {
+	response := &keycloakext.UserSession{
+		Id:         s.Id,
+		UserId:     s.UserId,
+		Username:   s.Username,
+		IpAddress:  s.IpAddress,
+		Start:      millisToTimestamp(s.Start),
+		LastAccess: millisToTimestamp(s.LastAccess),
+		RememberMe: s.RememberMe,
+		Offline:    s.Offline,
+	}
+
+	for id, clientId := range s.Clients {
+		response.Clients = append(response.Clients, &keycloakext.SessionClient{Id: id, ClientId: clientId})
+	}
+	sort.Slice(response.Clients, func(i, j int) bool {
+		return response.Clients[i].ClientId < response.Clients[j].ClientId
+	})
+
+	return response
+}
+
+func millisToTimestamp(millis int64) *timestamppb.Timestamp {
+	if millis == 0 {
+		return nil
+	}
+	return timestamppb.New(time.UnixMilli(millis))
+}
@@ -1,29 +1,69 @@
 package domain
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
 	user "github.com/hub1989/keycloak-protobuf/golang/keycloak"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"strings"
+	"time"
 )
 
 type UserRepresentation struct {
-	Id                         string                 `json:"id,omitempty"`
-	CreatedTimestamp           int64                  `json:"createdTimestamp,omitempty"`
-	Username                   string                 `json:"username,omitempty"`
-	Enabled                    bool                   `json:"enabled"`
-	Totp                       bool                   `json:"totp,omitempty"`
-	EmailVerified              bool                   `json:"emailVerified"`
-	FirstName                  string                 `json:"firstName,omitempty"`
-	LastName                   string                 `json:"lastName,omitempty"`
-	Email                      string                 `json:"email,omitempty"`
-	DisableableCredentialTypes []interface{}          `json:"disableableCredentialTypes,omitempty"`
-	RequiredActions            []interface{}          `json:"requiredActions,omitempty"`
-	NotBefore                  int                    `json:"notBefore,omitempty"`
-	Credentials                []Credential           `json:"credentials,omitempty"`
-	Access                     Access                 `json:"access,omitempty"`
-	Attributes                 map[string]interface{} `json:"attributes,omitempty"`
-	RealmRoles                 []string               `json:"realmRoles,omitempty"`
+	Id                         string              `json:"id,omitempty"`
+	CreatedTimestamp           int64               `json:"createdTimestamp,omitempty"`
+	Username                   string              `json:"username,omitempty"`
+	Enabled                    bool                `json:"enabled"`
+	Totp                       bool                `json:"totp,omitempty"`
+	EmailVerified              bool                `json:"emailVerified"`
+	FirstName                  string              `json:"firstName"`
+	LastName                   string              `json:"lastName"`
+	Email                      string              `json:"email,omitempty"`
+	DisableableCredentialTypes []interface{}       `json:"disableableCredentialTypes,omitempty"`
+	RequiredActions            []string            `json:"requiredActions"`
+	NotBefore                  int                 `json:"notBefore,omitempty"`
+	Credentials                []Credential        `json:"credentials,omitempty"`
+	Access                     Access              `json:"access,omitempty"`
+	Attributes                 map[string][]string `json:"attributes"`
+	RealmRoles                 []string            `json:"realmRoles,omitempty"`
+}
+
+// UserSearch holds the filters Keycloak accepts on GET /users and /users/count.
+type UserSearch struct {
+	Search        string
+	Username      string
+	Email         string
+	FirstName     string
+	LastName      string
+	Attributes    map[string]string
+	Enabled       *bool
+	EmailVerified *bool
+	Exact         bool
+	First         int
+	Max           int
+}
+
+// UserExpand selects the lookups made on top of reading a user.
+type UserExpand struct {
+	Roles  bool
+	Groups bool
+}
+
+func (e UserExpand) Any() bool {
+	return e.Roles || e.Groups
+}
+
+// UserMemberships are the roles mapped directly to a user and the groups the user belongs to.
+type UserMemberships struct {
+	UserId     string
+	RealmRoles []string
+	// ClientRoles maps a clientId to role names.
+	ClientRoles map[string][]string
+	Groups      []GroupOverview
 }
 
 type Credential struct {
@@ -32,6 +72,15 @@ type Credential struct {
 	Temporary bool   `json:"temporary"`
 }
 
+// StoredCredential is a credential as Keycloak lists it, without its secret.
+type StoredCredential struct {
+	Id          string `json:"id"`
+	Type        string `json:"type"`
+	UserLabel   string `json:"userLabel"`
+	CreatedDate int64  `json:"createdDate"`
+	Priority    int    `json:"priority"`
+}
+
 type Access struct {
 	ManageGroupMembership bool `json:"manageGroupMembership"`
 	View                  bool `json:"view"`
@@ -43,7 +92,7 @@ type Access struct {
 type OIDCInfo struct {
 	Subject             string   `json:"subject"`
 	Iss                 string   `json:"iss"`
-	Aud                 []string `json:"aud"`
+	Aud                 Audience `json:"aud"`
 	Sub                 string   `json:"sub"`
 	Name                string   `json:"name"`
 	GivenName           string   `json:"given_name"`
@@ -66,16 +115,61 @@ type OIDCInfo struct {
 	ClaimsLocales       string   `json:"claims_locales"`
 }
 
-func (r UserRepresentation) UserToGRpcResponse() user.UserResponse {
-	attributes := make(map[string]string)
-	for key, value := range r.Attributes {
-		x := fmt.Sprintf("%s", value)
-		x = strings.ReplaceAll(x, "[", "")
-		x = strings.ReplaceAll(x, "]", "")
+// Audience is the aud claim, which is a single string or a list.
+type Audience []string
+
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = list
+	return nil
+}
+
+func (i OIDCInfo) OIDCInfoToGRpcResponse() *keycloakext.UserInfoResponse {
+	response := &keycloakext.UserInfoResponse{
+		Sub:                 i.Sub,
+		Iss:                 i.Iss,
+		Aud:                 i.Aud,
+		Name:                i.Name,
+		GivenName:           i.GivenName,
+		FamilyName:          i.FamilyName,
+		MiddleName:          i.MiddleName,
+		Nickname:            i.Nickname,
+		PreferredUsername:   i.PreferredUsername,
+		Profile:             i.Profile,
+		Picture:             i.Picture,
+		Website:             i.Website,
+		Email:               i.Email,
+		EmailVerified:       i.EmailVerified,
+		Gender:              i.Gender,
+		Birthdate:           i.Birthdate,
+		Zoneinfo:            i.Zoneinfo,
+		Locale:              i.Locale,
+		PhoneNumber:         i.PhoneNumber,
+		PhoneNumberVerified: i.PhoneNumberVerified,
+	}
 
-		attributes[key] = x
+	if i.UpdatedAt > 0 {
+		response.UpdatedAt = timestamppb.New(time.Unix(int64(i.UpdatedAt), 0))
+	}
+	if response.Sub == "" {
+		response.Sub = i.Subject
 	}
 
+	return response
+}
+
+func (r UserRepresentation) UserToGRpcResponse() user.UserResponse {
+	attributes := FirstAttributeValues(r.Attributes)
+
 	phoneNumber := attributes["phoneNumber"]
 	return user.UserResponse{
 		Email:       r.Email,
@@ -89,22 +183,52 @@ func (r UserRepresentation) UserToGRpcResponse() user.UserResponse {
 	}
 }
 
+func (r UserRepresentation) UserAttributesToGRpcResponse() *keycloakext.UserAttributes {
+	return &keycloakext.UserAttributes{
+		UserId:     r.Id,
+		Attributes: AttributesToGRpc(r.Attributes),
+		Version:    r.Version(),
+	}
+}
+
+// Version fingerprints the stored user. Keycloak has no version or ETag for
+// users, so it is a hash of the representation, leaving out the caller
+// dependent access flags.
+func (r UserRepresentation) Version() string {
+	r.Access = Access{}
+	data, _ := json.Marshal(r)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:16])
+}
+
+// MergesAttributes reports whether paths only name single attributes, so
+// the update can be re-applied to a newer user without losing its changes.
+func MergesAttributes(paths []string) bool {
+	for _, path := range paths {
+		field, key, isAttribute := strings.Cut(path, ".")
+		if !isAttribute || key == "" || strings.ToLower(field) != "attributes" {
+			return false
+		}
+	}
+	return len(paths) > 0
+}
+
 func UserGRpcRequestToUser(request *user.UserRequest) UserRepresentation {
-	attributes := make(map[string]interface{})
+	attributes := make(map[string][]string)
 	for key, value := range request.Attributes {
-		attributes[key] = value
+		attributes[key] = []string{value}
 	}
 
 	userRepresentation := UserRepresentation{
 		Username:      request.Username,
 		Enabled:       request.Enabled,
 		EmailVerified: request.EmailVerified,
-		Email:         request.Email.Value,
+		Email:         request.GetEmail().GetValue(),
 		Attributes:    attributes,
 	}
 
 	if request.PhoneNumber != nil && request.PhoneNumber.Value != "" {
-		userRepresentation.Attributes["phoneNumber"] = request.PhoneNumber.Value
+		userRepresentation.Attributes["phoneNumber"] = []string{request.PhoneNumber.Value}
 	}
 
 	if request.FirstName != nil && request.FirstName.Value != "" {
@@ -129,32 +253,162 @@ func UserGRpcRequestToUser(request *user.UserRequest) UserRepresentation {
 	return userRepresentation
 }
 
+func (c StoredCredential) StoredCredentialToGRpcResponse() *keycloakext.Credential {
+	return &keycloakext.Credential{
+		Id:          c.Id,
+		Type:        c.Type,
+		UserLabel:   c.UserLabel,
+		CreatedDate: millisToTimestamp(c.CreatedDate),
+		Priority:    int32(c.Priority),
+	}
+}
+
+func UserSearchGRpcRequestToSearch(request *keycloakext.SearchUsersRequest) UserSearch {
+	search := UserSearch{
+		Search:     request.Search.GetValue(),
+		Username:   request.Username.GetValue(),
+		Email:      request.Email.GetValue(),
+		FirstName:  request.FirstName.GetValue(),
+		LastName:   request.LastName.GetValue(),
+		Attributes: request.Attributes,
+		Exact:      request.Exact,
+		First:      int(request.First),
+		Max:        int(request.Max),
+	}
+
+	if request.Enabled != nil {
+		search.Enabled = &request.Enabled.Value
+	}
+
+	if request.EmailVerified != nil {
+		search.EmailVerified = &request.EmailVerified.Value
+	}
+
+	return search
+}
+
+// UpdateUser applies the legacy update request. Names and the phone number
+// are written when set, an empty value clears them, and an empty attribute
+// value removes the attribute. The request cannot tell an unset enabled or
+// emailVerified from false, so those are left alone; use PatchUser instead.
 func (r UserRepresentation) UpdateUser(request *user.UpdateUserRequest) UserRepresentation {
-	if request.PhoneNumber != nil && request.PhoneNumber.Value != "" {
-		r.Attributes["phoneNumber"] = request.PhoneNumber.Value
+	patch := &keycloakext.UserPatch{
+		FirstName:   request.FirstName.GetValue(),
+		LastName:    request.LastName.GetValue(),
+		PhoneNumber: request.PhoneNumber.GetValue(),
+		Attributes:  make(map[string]*keycloakext.AttributeValues),
 	}
 
-	if request.FirstName != nil && request.FirstName.Value != "" {
-		r.FirstName = request.FirstName.Value
+	var paths []string
+	if request.FirstName != nil {
+		paths = append(paths, "firstName")
+	}
+	if request.LastName != nil {
+		paths = append(paths, "lastName")
+	}
+	if request.PhoneNumber != nil {
+		paths = append(paths, "phoneNumber")
+	}
+	for key, value := range request.Attributes {
+		if value != "" {
+			patch.Attributes[key] = &keycloakext.AttributeValues{Values: []string{value}}
+		}
+		paths = append(paths, "attributes."+key)
 	}
 
-	if request.LastName != nil && request.LastName.Value != "" {
-		r.LastName = request.LastName.Value
+	// the paths are all known, so this cannot fail
+	updated, _ := r.PatchUser(patch, paths)
+	return updated
+}
+
+// PatchUser writes the fields of patch named by paths, see
+// keycloakext.PatchUserRequest. Paths may use the proto or the snake case
+// field names. The receiver's attributes are not modified.
+func (r UserRepresentation) PatchUser(patch *keycloakext.UserPatch, paths []string) (UserRepresentation, error) {
+	attributes := make(map[string][]string, len(r.Attributes))
+	for key, values := range r.Attributes {
+		attributes[key] = values
+	}
+	r.Attributes = attributes
+
+	for _, path := range paths {
+		field, key, isAttribute := strings.Cut(path, ".")
+		switch strings.ToLower(strings.ReplaceAll(field, "_", "")) {
+		case "email":
+			if patch.GetEmail() == "" {
+				return r, fmt.Errorf("email cannot be cleared")
+			}
+			r.Email = patch.GetEmail()
+		case "firstname":
+			r.FirstName = patch.GetFirstName()
+		case "lastname":
+			r.LastName = patch.GetLastName()
+		case "phonenumber":
+			setAttribute(r.Attributes, "phoneNumber", []string{patch.GetPhoneNumber()})
+		case "enabled":
+			r.Enabled = patch.GetEnabled()
+		case "emailverified":
+			r.EmailVerified = patch.GetEmailVerified()
+		case "attributes":
+			if !isAttribute {
+				r.Attributes = make(map[string][]string, len(patch.GetAttributes()))
+				for key, values := range patch.GetAttributes() {
+					setAttribute(r.Attributes, key, values.GetValues())
+				}
+				continue
+			}
+			if key == "" {
+				return r, fmt.Errorf("update path %q has no attribute name", path)
+			}
+			setAttribute(r.Attributes, key, patch.GetAttributes()[key].GetValues())
+		default:
+			return r, fmt.Errorf("unknown update path %q", path)
+		}
+	}
+
+	return r, nil
+}
+
+// setAttribute removes the attribute when it has no non-empty values.
+func setAttribute(attributes map[string][]string, key string, values []string) {
+	var kept []string
+	for _, value := range values {
+		if value != "" {
+			kept = append(kept, value)
+		}
+	}
+
+	if len(kept) == 0 {
+		delete(attributes, key)
+		return
 	}
+	attributes[key] = kept
+}
 
-	if r.Enabled != request.Enabled {
-		r.Enabled = request.Enabled
+func UserExpandGRpcRequestToExpand(request *keycloakext.UserExpand) UserExpand {
+	return UserExpand{
+		Roles:  request.GetRoles(),
+		Groups: request.GetGroups(),
 	}
+}
 
-	if r.EmailVerified != request.EmailVerified {
-		r.EmailVerified = request.EmailVerified
+func (m UserMemberships) MembershipsToGRpcResponse() *keycloakext.UserMemberships {
+	response := &keycloakext.UserMemberships{
+		UserId:     m.UserId,
+		RealmRoles: m.RealmRoles,
 	}
 
-	if request.Attributes != nil {
-		for key, value := range request.Attributes {
-			r.Attributes[key] = value
+	if len(m.ClientRoles) > 0 {
+		response.ClientRoles = make(map[string]*keycloakext.RoleNames, len(m.ClientRoles))
+		for clientId, names := range m.ClientRoles {
+			response.ClientRoles[clientId] = &keycloakext.RoleNames{Names: names}
 		}
 	}
 
-	return r
+	for _, group := range m.Groups {
+		gRpcResponse := group.GroupOverviewToGRpcResponse()
+		response.Groups = append(response.Groups, &gRpcResponse)
+	}
+
+	return response
 }
@@ -0,0 +1,268 @@
+package domain
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
+	user "github.com/hub1989/keycloak-protobuf/golang/keycloak"
+	"reflect"
+	"testing"
+)
+
+func TestUserGRpcRequestToUser(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *user.UserRequest
+		want    UserRepresentation
+	}{
+		{
+			name:    "no attributes",
+			request: &user.UserRequest{Username: "jane", Email: &wrappers.StringValue{Value: "jane@example.com"}},
+			want:    UserRepresentation{Username: "jane", Email: "jane@example.com", Attributes: map[string][]string{}},
+		},
+		{
+			name:    "no email",
+			request: &user.UserRequest{Username: "jane"},
+			want:    UserRepresentation{Username: "jane", Attributes: map[string][]string{}},
+		},
+		{
+			name: "attributes",
+			request: &user.UserRequest{
+				Username: "jane",
+				Attributes: map[string]string{
+					"address": "1 Main Street, Springfield",
+					"note":    "[draft] (v2)",
+					"tags":    "a,b,c",
+				},
+			},
+			want: UserRepresentation{Username: "jane", Attributes: map[string][]string{
+				"address": {"1 Main Street, Springfield"},
+				"note":    {"[draft] (v2)"},
+				"tags":    {"a,b,c"},
+			}},
+		},
+		{
+			name: "profile fields",
+			request: &user.UserRequest{
+				Username:      "jane",
+				Enabled:       true,
+				EmailVerified: true,
+				PhoneNumber:   &wrappers.StringValue{Value: "+49 30 1234"},
+				FirstName:     &wrappers.StringValue{Value: "Jane Ann"},
+				LastName:      &wrappers.StringValue{Value: "Doe"},
+				Password:      "secret",
+			},
+			want: UserRepresentation{
+				Username:      "jane",
+				Enabled:       true,
+				EmailVerified: true,
+				FirstName:     "Jane Ann",
+				LastName:      "Doe",
+				Attributes:    map[string][]string{"phoneNumber": {"+49 30 1234"}},
+				Credentials:   []Credential{{Value: "secret", Type: "password"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UserGRpcRequestToUser(tt.request); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UserGRpcRequestToUser() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateUser(t *testing.T) {
+	stored := UserRepresentation{
+		Id:            "id",
+		FirstName:     "Jane",
+		LastName:      "Doe",
+		Enabled:       true,
+		EmailVerified: true,
+		Attributes:    map[string][]string{"phoneNumber": {"+49 30 1234"}, "team": {"a", "b"}},
+	}
+
+	tests := []struct {
+		name    string
+		stored  UserRepresentation
+		request *user.UpdateUserRequest
+		want    UserRepresentation
+	}{
+		{
+			name:    "nothing set",
+			stored:  stored,
+			request: &user.UpdateUserRequest{},
+			want:    stored,
+		},
+		{
+			name:    "clear first name",
+			stored:  stored,
+			request: &user.UpdateUserRequest{FirstName: &wrappers.StringValue{}},
+			want: UserRepresentation{Id: "id", LastName: "Doe", Enabled: true, EmailVerified: true,
+				Attributes: map[string][]string{"phoneNumber": {"+49 30 1234"}, "team": {"a", "b"}}},
+		},
+		{
+			name:    "clear phone number",
+			stored:  stored,
+			request: &user.UpdateUserRequest{PhoneNumber: &wrappers.StringValue{}},
+			want: UserRepresentation{Id: "id", FirstName: "Jane", LastName: "Doe", Enabled: true, EmailVerified: true,
+				Attributes: map[string][]string{"team": {"a", "b"}}},
+		},
+		{
+			name:    "enabled and emailVerified left alone",
+			stored:  stored,
+			request: &user.UpdateUserRequest{LastName: &wrappers.StringValue{Value: "Roe"}},
+			want: UserRepresentation{Id: "id", FirstName: "Jane", LastName: "Roe", Enabled: true, EmailVerified: true,
+				Attributes: map[string][]string{"phoneNumber": {"+49 30 1234"}, "team": {"a", "b"}}},
+		},
+		{
+			name:    "set and remove attributes",
+			stored:  stored,
+			request: &user.UpdateUserRequest{Attributes: map[string]string{"team": "", "office": "Berlin"}},
+			want: UserRepresentation{Id: "id", FirstName: "Jane", LastName: "Doe", Enabled: true, EmailVerified: true,
+				Attributes: map[string][]string{"phoneNumber": {"+49 30 1234"}, "office": {"Berlin"}}},
+		},
+		{
+			name:    "stored user without attributes",
+			stored:  UserRepresentation{Id: "id"},
+			request: &user.UpdateUserRequest{PhoneNumber: &wrappers.StringValue{Value: "+49 30 1234"}},
+			want:    UserRepresentation{Id: "id", Attributes: map[string][]string{"phoneNumber": {"+49 30 1234"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.stored.UpdateUser(tt.request); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateUser() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatchUser(t *testing.T) {
+	stored := UserRepresentation{
+		Id:            "id",
+		Email:         "jane@example.com",
+		FirstName:     "Jane",
+		Enabled:       true,
+		EmailVerified: true,
+		Attributes:    map[string][]string{"phoneNumber": {"+49 30 1234"}, "team": {"a", "b"}},
+	}
+	values := func(values ...string) *keycloakext.AttributeValues {
+		return &keycloakext.AttributeValues{Values: values}
+	}
+
+	tests := []struct {
+		name    string
+		stored  UserRepresentation
+		patch   *keycloakext.UserPatch
+		paths   []string
+		want    UserRepresentation
+		wantErr bool
+	}{
+		{
+			name:   "clear first name",
+			stored: stored,
+			patch:  &keycloakext.UserPatch{},
+			paths:  []string{"firstName"},
+			want: UserRepresentation{Id: "id", Email: "jane@example.com", Enabled: true, EmailVerified: true,
+				Attributes: map[string][]string{"phoneNumber": {"+49 30 1234"}, "team": {"a", "b"}}},
+		},
+		{
+			name:   "clear phone number",
+			stored: stored,
+			patch:  &keycloakext.UserPatch{},
+			paths:  []string{"phone_number"},
+			want: UserRepresentation{Id: "id", Email: "jane@example.com", FirstName: "Jane", Enabled: true, EmailVerified: true,
+				Attributes: map[string][]string{"team": {"a", "b"}}},
+		},
+		{
+			name:   "enabled and emailVerified outside the mask",
+			stored: stored,
+			patch:  &keycloakext.UserPatch{FirstName: "Ann"},
+			paths:  []string{"firstName"},
+			want: UserRepresentation{Id: "id", Email: "jane@example.com", FirstName: "Ann", Enabled: true, EmailVerified: true,
+				Attributes: map[string][]string{"phoneNumber": {"+49 30 1234"}, "team": {"a", "b"}}},
+		},
+		{
+			name:   "disable",
+			stored: stored,
+			patch:  &keycloakext.UserPatch{},
+			paths:  []string{"enabled", "email_verified"},
+			want: UserRepresentation{Id: "id", Email: "jane@example.com", FirstName: "Jane",
+				Attributes: map[string][]string{"phoneNumber": {"+49 30 1234"}, "team": {"a", "b"}}},
+		},
+		{
+			name:   "remove one attribute",
+			stored: stored,
+			patch:  &keycloakext.UserPatch{},
+			paths:  []string{"attributes.team"},
+			want: UserRepresentation{Id: "id", Email: "jane@example.com", FirstName: "Jane", Enabled: true, EmailVerified: true,
+				Attributes: map[string][]string{"phoneNumber": {"+49 30 1234"}}},
+		},
+		{
+			name:   "replace one attribute",
+			stored: stored,
+			patch:  &keycloakext.UserPatch{Attributes: map[string]*keycloakext.AttributeValues{"team": values("c"), "office": values("Berlin")}},
+			paths:  []string{"attributes.team"},
+			want: UserRepresentation{Id: "id", Email: "jane@example.com", FirstName: "Jane", Enabled: true, EmailVerified: true,
+				Attributes: map[string][]string{"phoneNumber": {"+49 30 1234"}, "team": {"c"}}},
+		},
+		{
+			name:   "replace every attribute",
+			stored: stored,
+			patch:  &keycloakext.UserPatch{Attributes: map[string]*keycloakext.AttributeValues{"office": values("Berlin", ""), "empty": values("")}},
+			paths:  []string{"attributes"},
+			want: UserRepresentation{Id: "id", Email: "jane@example.com", FirstName: "Jane", Enabled: true, EmailVerified: true,
+				Attributes: map[string][]string{"office": {"Berlin"}}},
+		},
+		{
+			name:   "stored user without attributes",
+			stored: UserRepresentation{Id: "id"},
+			patch:  &keycloakext.UserPatch{PhoneNumber: "+49 30 1234", Attributes: map[string]*keycloakext.AttributeValues{"team": values("a")}},
+			paths:  []string{"phoneNumber", "attributes.team"},
+			want:   UserRepresentation{Id: "id", Attributes: map[string][]string{"phoneNumber": {"+49 30 1234"}, "team": {"a"}}},
+		},
+		{
+			name:    "empty email",
+			stored:  stored,
+			patch:   &keycloakext.UserPatch{},
+			paths:   []string{"email"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown path",
+			stored:  stored,
+			patch:   &keycloakext.UserPatch{},
+			paths:   []string{"username"},
+			wantErr: true,
+		},
+		{
+			name:    "attribute path without a name",
+			stored:  stored,
+			patch:   &keycloakext.UserPatch{},
+			paths:   []string{"attributes."},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.stored.PatchUser(tt.patch, tt.paths)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PatchUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PatchUser() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatchUserKeepsStoredAttributes(t *testing.T) {
+	stored := UserRepresentation{Attributes: map[string][]string{"team": {"a"}}}
+
+	if _, err := stored.PatchUser(&keycloakext.UserPatch{}, []string{"attributes.team"}); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(stored.Attributes, map[string][]string{"team": {"a"}}) {
+		t.Errorf("stored attributes = %v, want them unchanged", stored.Attributes)
+	}
+}
@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"github.com/hub1989/keycloak-grpc-service/keycloak"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net"
+	"net/url"
+	"strings"
+)
+
+type (
+	claimsKey struct{}
+	callerKey struct{}
+)
+
+// Caller is filled in by the Authenticator once it has verified the token.
+// Interceptors that run before authentication, such as the call logger, add
+// one to the context with WithCaller to learn who made a call, including
+// calls that were later rejected.
+type Caller struct {
+	claims *domain.TokenClaims
+}
+
+// Authenticator rejects calls that do not carry a valid realm-issued bearer
+// token in the authorization metadata, and stores the verified claims in the
+// request context for the handlers.
+type Authenticator struct {
+	keycloak.TokenVerifier
+	// PublicMethods are full method names, or service prefixes ending in "/", served without a token.
+	PublicMethods []string
+}
+
+func ClaimsFromContext(ctx context.Context) (domain.TokenClaims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(domain.TokenClaims)
+	return claims, ok
+}
+
+// WithCaller returns a context in which the Authenticator records the caller, and the Caller it fills in.
+func WithCaller(ctx context.Context) (context.Context, *Caller) {
+	caller := &Caller{}
+	return context.WithValue(ctx, callerKey{}, caller), caller
+}
+
+// Claims returns the verified claims, if the call got as far as authentication and carried a valid token.
+func (c *Caller) Claims() (domain.TokenClaims, bool) {
+	if c.claims == nil {
+		return domain.TokenClaims{}, false
+	}
+	return *c.claims, true
+}
+
+func (a Authenticator) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a Authenticator) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+}
+
+func (a Authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if a.isPublic(method) {
+		return ctx, nil
+	}
+
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	claims, err := a.TokenVerifier.VerifyToken(ctx, token)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		// the token cannot be judged while the realm keys are unreachable
+		if keysUnavailable(err) {
+			log.WithField("method", method).WithError(err).Error("could not verify bearer token")
+			return nil, status.Error(codes.Unavailable, "could not verify bearer token")
+		}
+		log.WithField("method", method).WithError(err).Warn("rejected unauthenticated call")
+		return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+
+	if caller, ok := ctx.Value(callerKey{}).(*Caller); ok {
+		caller.claims = &claims
+	}
+	return context.WithValue(ctx, claimsKey{}, claims), nil
+}
+
+// keysUnavailable reports whether verification failed because Keycloak could
+// not be asked for the realm keys, rather than because of the token.
+func keysUnavailable(err error) bool {
+	var keycloakErr *keycloak.Error
+	if errors.As(err, &keycloakErr) {
+		return true
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func (a Authenticator) isPublic(method string) bool {
+	return matchesMethod(a.PublicMethods, method)
+}
+
+// matchesMethod reports whether method is one of methods, or belongs to a service prefix ending in "/".
+func matchesMethod(methods []string, method string) bool {
+	for _, m := range methods {
+		if method == m || (strings.HasSuffix(m, "/") && strings.HasPrefix(method, m)) {
+			return true
+		}
+	}
+	return false
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	for _, value := range md.Get("authorization") {
+		scheme, token, found := strings.Cut(value, " ")
+		if found && strings.EqualFold(scheme, "bearer") && token != "" {
+			return strings.TrimSpace(token), true
+		}
+	}
+	return "", false
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
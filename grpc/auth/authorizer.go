@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Rule lists what a caller must hold to invoke a method. Every role and scope listed is required.
+type Rule struct {
+	RealmRoles []string `json:"realmRoles"`
+	// ClientRoles maps a clientId to the roles required on that client.
+	ClientRoles map[string][]string `json:"clientRoles"`
+	Scopes      []string            `json:"scopes"`
+}
+
+// Policy maps full gRPC method names, e.g. "/keycloak.UserService/DeleteUser", to their rule.
+// Methods without a rule fall back to Default and are denied when there is none.
+type Policy struct {
+	Methods map[string]Rule `json:"methods"`
+	// Default applies to every method without a rule of its own. An empty rule
+	// admits any authenticated caller.
+	Default *Rule `json:"default"`
+}
+
+// Authorizer enforces a Policy against the claims the Authenticator stored in the context.
+type Authorizer struct {
+	Policy
+	// PublicMethods are served without a token, so the policy does not apply to them.
+	// They use the same form as Authenticator.PublicMethods.
+	PublicMethods []string
+}
+
+func LoadPolicy(path string) (Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Policy{}, err
+	}
+
+	var policy Policy
+	if err = json.Unmarshal(data, &policy); err != nil {
+		return Policy{}, fmt.Errorf("could not parse policy %s: %w", path, err)
+	}
+
+	for method := range policy.Methods {
+		if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 {
+			return Policy{}, fmt.Errorf("policy %s: %q is not a full method name like /package.Service/Method", path, method)
+		}
+	}
+
+	return policy, nil
+}
+
+// Validate fails when a rule names a method the server does not serve. Such a
+// rule is usually a typo, and the method it meant would silently fall back to
+// the default rule.
+func (p Policy) Validate(services map[string]grpc.ServiceInfo) error {
+	var unknown []string
+	for method := range p.Methods {
+		service, name, _ := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+		if !serves(services[service], name) {
+			unknown = append(unknown, method)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("policy has rules for methods the server does not serve: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+func serves(service grpc.ServiceInfo, name string) bool {
+	for _, method := range service.Methods {
+		if method.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (a Authorizer) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a Authorizer) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (a Authorizer) authorize(ctx context.Context, method string) error {
+	if matchesMethod(a.PublicMethods, method) {
+		return nil
+	}
+
+	rule, ok := a.Methods[method]
+	if !ok {
+		if a.Default == nil {
+			return status.Error(codes.PermissionDenied, "method is not allowed by the authorization policy")
+		}
+		rule = *a.Default
+	}
+
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "caller is not authenticated")
+	}
+
+	missing := rule.missing(claims)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	st, err := status.New(codes.PermissionDenied, fmt.Sprintf("caller is missing %s", strings.Join(missing, ", "))).
+		WithDetails(&errdetails.ErrorInfo{
+			Reason: "MISSING_ROLE",
+			Domain: "keycloak-grpc-service",
+			Metadata: map[string]string{
+				"method":  method,
+				"missing": strings.Join(missing, ","),
+			},
+		})
+	if err != nil {
+		return status.Error(codes.PermissionDenied, "caller is missing a required role")
+	}
+	return st.Err()
+}
+
+// missing returns what the caller lacks, as "realm:<role>", "client:<clientId>:<role>" and "scope:<scope>".
+func (r Rule) missing(claims domain.TokenClaims) []string {
+	var missing []string
+
+	for _, role := range r.RealmRoles {
+		if !contains(claims.RealmRoles, role) {
+			missing = append(missing, "realm:"+role)
+		}
+	}
+
+	clients := make([]string, 0, len(r.ClientRoles))
+	for client := range r.ClientRoles {
+		clients = append(clients, client)
+	}
+	sort.Strings(clients)
+
+	for _, client := range clients {
+		for _, role := range r.ClientRoles[client] {
+			if !contains(claims.ClientRoles[client], role) {
+				missing = append(missing, fmt.Sprintf("client:%s:%s", client, role))
+			}
+		}
+	}
+
+	for _, scope := range r.Scopes {
+		if !contains(claims.Scopes, scope) {
+			missing = append(missing, "scope:"+scope)
+		}
+	}
+
+	return missing
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
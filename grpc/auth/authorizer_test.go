@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"testing"
+)
+
+func TestAuthorize(t *testing.T) {
+	admin := context.WithValue(context.Background(), claimsKey{}, domain.TokenClaims{RealmRoles: []string{"user-admin"}})
+	caller := context.WithValue(context.Background(), claimsKey{}, domain.TokenClaims{})
+
+	methods := map[string]Rule{"/keycloak.UserService/DeleteUser": {RealmRoles: []string{"user-admin"}}}
+	tests := []struct {
+		name   string
+		policy Policy
+		ctx    context.Context
+		method string
+		want   codes.Code
+	}{
+		{"rule satisfied", Policy{Methods: methods}, admin, "/keycloak.UserService/DeleteUser", codes.OK},
+		{"rule not satisfied", Policy{Methods: methods}, caller, "/keycloak.UserService/DeleteUser", codes.PermissionDenied},
+		{"no rule and no default", Policy{Methods: methods}, admin, "/keycloak.UserService/GetUserById", codes.PermissionDenied},
+		{"empty default", Policy{Methods: methods, Default: &Rule{}}, caller, "/keycloak.UserService/GetUserById", codes.OK},
+		{"default not satisfied", Policy{Methods: methods, Default: &Rule{RealmRoles: []string{"user-viewer"}}}, caller, "/keycloak.UserService/GetUserById", codes.PermissionDenied},
+		{"public method", Policy{Methods: methods}, context.Background(), "/keycloak.ext.TokenService/RefreshToken", codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authorizer := Authorizer{Policy: tt.policy, PublicMethods: []string{"/keycloak.ext.TokenService/"}}
+			if got := status.Code(authorizer.authorize(tt.ctx, tt.method)); got != tt.want {
+				t.Errorf("authorize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	services := map[string]grpc.ServiceInfo{
+		"keycloak.UserService": {Methods: []grpc.MethodInfo{{Name: "DeleteUser"}}},
+	}
+
+	valid := Policy{Methods: map[string]Rule{"/keycloak.UserService/DeleteUser": {}}}
+	if err := valid.Validate(services); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+
+	invalid := Policy{Methods: map[string]Rule{
+		"/keycloak.UserService/DeleteUsers":     {},
+		"/keycloak.UserAdminService/DeleteUser": {},
+	}}
+	err := invalid.Validate(services)
+	if err == nil {
+		t.Fatal("Validate() = nil, want an error")
+	}
+	for _, method := range []string{"/keycloak.UserService/DeleteUsers", "/keycloak.UserAdminService/DeleteUser"} {
+		if !strings.Contains(err.Error(), method) {
+			t.Errorf("Validate() = %v, want it to name %s", err, method)
+		}
+	}
+}
@@ -19,12 +19,12 @@ type ClientController struct {
 func (c ClientController) GetClients(ctx context.Context, in *empty.Empty) (*user.ClientsResponse, error) {
 	token, err := c.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	clients, err := c.ClientService.GetClients(ctx, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	var response []*user.ClientResponse
@@ -43,12 +43,12 @@ func (c ClientController) GetClientByClientId(ctx context.Context, in *wrappers.
 	}
 	token, err := c.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	client, err := c.ClientService.GetClientByClientId(ctx, in.Value, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	resp := client.ClientToGRpcResponse()
@@ -61,12 +61,12 @@ func (c ClientController) GetClientById(ctx context.Context, in *wrappers.String
 	}
 	token, err := c.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	client, err := c.ClientService.GetClientById(ctx, in.Value, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	resp := client.ClientToGRpcResponse()
@@ -84,12 +84,12 @@ func (c ClientController) GetClientsByIds(ctx context.Context, in *user.StringsR
 	}
 	token, err := c.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	clients, err := c.ClientService.GetClientsByIds(ctx, ids, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	var response []*user.ClientResponse
@@ -113,12 +113,12 @@ func (c ClientController) GetClientsByClientIds(ctx context.Context, in *user.St
 	}
 	token, err := c.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	clients, err := c.ClientService.GetClientsByClientIds(ctx, ids, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	var response []*user.ClientResponse
@@ -0,0 +1,404 @@
+package controller
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
+	"github.com/hub1989/keycloak-grpc-service/keycloak"
+	user "github.com/hub1989/keycloak-protobuf/golang/keycloak"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var roleMappingViews = map[keycloakext.RoleMappingView]keycloak.RoleMappingView{
+	keycloakext.RoleMappingView_ASSIGNED:  keycloak.AssignedRoles,
+	keycloakext.RoleMappingView_AVAILABLE: keycloak.AvailableRoles,
+	keycloakext.RoleMappingView_EFFECTIVE: keycloak.EffectiveRoles,
+}
+
+func (r RoleController) ListClientRoles(ctx context.Context, in *keycloakext.ListClientRolesRequest) (*keycloakext.RolesPage, error) {
+	if in == nil || missingClient(in.Client) {
+		return nil, status.Error(codes.InvalidArgument, "client id or clientId cannot be nil or empty")
+	}
+
+	first, max, ok := page(in.First, in.Max, in.PageToken)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "first, max or pageToken is invalid")
+	}
+
+	search := domain.RoleSearch{Brief: in.Brief, First: first, Max: max}
+	if in.Search != nil {
+		search.Search = in.Search.Value
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	clientId, err := r.clientUuid(ctx, in.Client, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	roles, err := r.RoleService.GetClientRoles(ctx, clientId, search, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &keycloakext.RolesPage{
+		Roles:         rolesToGRpc(roles),
+		NextPageToken: nextPageToken(first, max, len(roles)),
+	}, nil
+}
+
+func (r RoleController) GetClientRole(ctx context.Context, in *keycloakext.ClientRoleRequest) (*keycloakext.Role, error) {
+	if in == nil || missingClient(in.Client) {
+		return nil, status.Error(codes.InvalidArgument, "client id or clientId cannot be nil or empty")
+	}
+
+	if in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	clientId, err := r.clientUuid(ctx, in.Client, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	role, err := r.RoleService.GetClientRole(ctx, clientId, in.Name, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return role.RoleToGRpcRole(), nil
+}
+
+func (r RoleController) CreateClientRole(ctx context.Context, in *keycloakext.CreateClientRoleRequest) (*keycloakext.Role, error) {
+	if in == nil || missingClient(in.Client) {
+		return nil, status.Error(codes.InvalidArgument, "client id or clientId cannot be nil or empty")
+	}
+
+	if in.Role == nil || in.Role.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	clientId, err := r.clientUuid(ctx, in.Client, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = r.RoleService.CreateClientRole(ctx, clientId, domain.RoleGRpcRequestToRole(in.Role), token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	role, err := r.RoleService.GetClientRole(ctx, clientId, in.Role.Name, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return role.RoleToGRpcRole(), nil
+}
+
+func (r RoleController) UpdateClientRole(ctx context.Context, in *keycloakext.UpdateClientRoleRequest) (*keycloakext.Role, error) {
+	if in == nil || missingClient(in.Client) {
+		return nil, status.Error(codes.InvalidArgument, "client id or clientId cannot be nil or empty")
+	}
+
+	if in.Role == nil || in.Role.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	clientId, err := r.clientUuid(ctx, in.Client, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	role, err := r.RoleService.GetClientRole(ctx, clientId, in.Role.Name, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	role.UpdateRole(in.Role)
+
+	err = r.RoleService.UpdateClientRole(ctx, clientId, in.Role.Name, role, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return role.RoleToGRpcRole(), nil
+}
+
+func (r RoleController) DeleteClientRole(ctx context.Context, in *keycloakext.ClientRoleRequest) (*empty.Empty, error) {
+	if in == nil || missingClient(in.Client) {
+		return nil, status.Error(codes.InvalidArgument, "client id or clientId cannot be nil or empty")
+	}
+
+	if in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	clientId, err := r.clientUuid(ctx, in.Client, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = r.RoleService.DeleteClientRole(ctx, clientId, in.Name, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r RoleController) ListClientRoleUsers(ctx context.Context, in *keycloakext.ClientRoleMembersRequest) (*keycloakext.SearchUsersResponse, error) {
+	if in == nil || missingClient(in.Client) {
+		return nil, status.Error(codes.InvalidArgument, "client id or clientId cannot be nil or empty")
+	}
+
+	if in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	first, max, ok := page(in.First, in.Max, in.PageToken)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "first, max or pageToken is invalid")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	clientId, err := r.clientUuid(ctx, in.Client, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	users, err := r.RoleService.GetClientRoleUsers(ctx, clientId, in.Name, first, max, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	var response []*user.UserResponse
+	for _, representation := range users {
+		gRpcResponse := representation.UserToGRpcResponse()
+		response = append(response, &gRpcResponse)
+	}
+
+	return &keycloakext.SearchUsersResponse{
+		Users:          response,
+		NextPageToken:  nextPageToken(first, max, len(users)),
+		UserAttributes: usersAttributes(users),
+	}, nil
+}
+
+func (r RoleController) ListClientRoleGroups(ctx context.Context, in *keycloakext.ClientRoleMembersRequest) (*keycloakext.RoleGroupsResponse, error) {
+	if in == nil || missingClient(in.Client) {
+		return nil, status.Error(codes.InvalidArgument, "client id or clientId cannot be nil or empty")
+	}
+
+	if in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	first, max, ok := page(in.First, in.Max, in.PageToken)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "first, max or pageToken is invalid")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	clientId, err := r.clientUuid(ctx, in.Client, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	groups, err := r.RoleService.GetClientRoleGroups(ctx, clientId, in.Name, first, max, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	var response []*user.GroupResponse
+	for _, group := range groups {
+		gRpcResponse := group.GroupOverviewToGRpcResponse()
+		response = append(response, &gRpcResponse)
+	}
+
+	return &keycloakext.RoleGroupsResponse{
+		Groups:        response,
+		NextPageToken: nextPageToken(first, max, len(groups)),
+	}, nil
+}
+
+func (r RoleController) AssignClientRoles(ctx context.Context, in *keycloakext.ClientRoleMappingRequest) (*empty.Empty, error) {
+	if err := validateClientRoleMapping(in); err != nil {
+		return nil, err
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	clientId, err := r.clientUuid(ctx, in.Client, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	roles, err := r.clientRolesByName(ctx, clientId, in.Roles, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	if in.GetUserId() != "" {
+		err = r.RoleService.AssignClientRolesToUser(ctx, in.GetUserId(), clientId, roles, token.AccessToken)
+	} else {
+		err = r.RoleService.AssignClientRolesToGroup(ctx, in.GetGroupId(), clientId, roles, token.AccessToken)
+	}
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r RoleController) RemoveClientRoles(ctx context.Context, in *keycloakext.ClientRoleMappingRequest) (*empty.Empty, error) {
+	if err := validateClientRoleMapping(in); err != nil {
+		return nil, err
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	clientId, err := r.clientUuid(ctx, in.Client, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	roles, err := r.clientRolesByName(ctx, clientId, in.Roles, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	if in.GetUserId() != "" {
+		err = r.RoleService.RemoveClientRolesFromUser(ctx, in.GetUserId(), clientId, roles, token.AccessToken)
+	} else {
+		err = r.RoleService.RemoveClientRolesFromGroup(ctx, in.GetGroupId(), clientId, roles, token.AccessToken)
+	}
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r RoleController) ListClientRoleMappings(ctx context.Context, in *keycloakext.ListClientRoleMappingsRequest) (*keycloakext.RolesResponse, error) {
+	if in == nil || (in.GetUserId() == "" && in.GetGroupId() == "") {
+		return nil, status.Error(codes.InvalidArgument, "userId or groupId cannot be nil or empty")
+	}
+
+	if missingClient(in.Client) {
+		return nil, status.Error(codes.InvalidArgument, "client id or clientId cannot be nil or empty")
+	}
+
+	view, ok := roleMappingViews[in.View]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "view is invalid")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	clientId, err := r.clientUuid(ctx, in.Client, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	var roles []domain.Role
+	if in.GetUserId() != "" {
+		roles, err = r.RoleService.GetUserClientRoles(ctx, in.GetUserId(), clientId, view, token.AccessToken)
+	} else {
+		roles, err = r.RoleService.GetGroupClientRoles(ctx, in.GetGroupId(), clientId, view, token.AccessToken)
+	}
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &keycloakext.RolesResponse{Roles: rolesToGRpc(roles)}, nil
+}
+
+func validateClientRoleMapping(in *keycloakext.ClientRoleMappingRequest) error {
+	if in == nil || (in.GetUserId() == "" && in.GetGroupId() == "") {
+		return status.Error(codes.InvalidArgument, "userId or groupId cannot be nil or empty")
+	}
+
+	if missingClient(in.Client) {
+		return status.Error(codes.InvalidArgument, "client id or clientId cannot be nil or empty")
+	}
+
+	if len(in.Roles) == 0 {
+		return status.Error(codes.InvalidArgument, "roles cannot be nil or empty")
+	}
+
+	return nil
+}
+
+func missingClient(ref *keycloakext.ClientRef) bool {
+	return ref.GetId() == "" && ref.GetClientId() == ""
+}
+
+// clientUuid resolves a client reference to the internal id Keycloak's admin API expects.
+func (r RoleController) clientUuid(ctx context.Context, ref *keycloakext.ClientRef, token string) (string, error) {
+	if ref.GetId() != "" {
+		return ref.GetId(), nil
+	}
+
+	client, err := r.ClientService.GetClientByClientId(ctx, ref.GetClientId(), token)
+	if err != nil {
+		return "", err
+	}
+	return client.Id, nil
+}
+
+// clientRolesByName looks up the roles, as Keycloak needs their ids to change role mappings.
+func (r RoleController) clientRolesByName(ctx context.Context, clientId string, names []string, token string) ([]domain.Role, error) {
+	roles := make([]domain.Role, 0, len(names))
+	for _, name := range names {
+		role, err := r.RoleService.GetClientRole(ctx, clientId, name, token)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
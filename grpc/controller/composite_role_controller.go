@@ -0,0 +1,185 @@
+package controller
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (r RoleController) AddCompositeRoles(ctx context.Context, in *keycloakext.CompositeRolesRequest) (*empty.Empty, error) {
+	if err := validateCompositeRoles(in); err != nil {
+		return nil, err
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	clientId, err := r.roleClientUuid(ctx, in.Role, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	composites, err := r.rolesByRef(ctx, in.Composites, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = r.RoleService.AddCompositeRoles(ctx, clientId, in.Role.Name, composites, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r RoleController) RemoveCompositeRoles(ctx context.Context, in *keycloakext.CompositeRolesRequest) (*empty.Empty, error) {
+	if err := validateCompositeRoles(in); err != nil {
+		return nil, err
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	clientId, err := r.roleClientUuid(ctx, in.Role, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	composites, err := r.rolesByRef(ctx, in.Composites, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = r.RoleService.RemoveCompositeRoles(ctx, clientId, in.Role.Name, composites, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r RoleController) ListCompositeRoles(ctx context.Context, in *keycloakext.RoleRef) (*keycloakext.RolesResponse, error) {
+	if in == nil || in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	clientId, err := r.roleClientUuid(ctx, in, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	roles, err := r.RoleService.GetCompositeRoles(ctx, clientId, in.Name, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &keycloakext.RolesResponse{Roles: rolesToGRpc(roles)}, nil
+}
+
+func (r RoleController) GetEffectiveUserRoles(ctx context.Context, in *keycloakext.EffectiveRolesRequest) (*keycloakext.EffectiveRolesResponse, error) {
+	if in == nil || in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "userId cannot be nil or empty")
+	}
+
+	for _, client := range in.Clients {
+		if missingClient(client) {
+			return nil, status.Error(codes.InvalidArgument, "client id or clientId cannot be nil or empty")
+		}
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	var clientIds []string
+	for _, client := range in.Clients {
+		clientId, err := r.clientUuid(ctx, client, token.AccessToken)
+		if err != nil {
+			return nil, keycloakError(err)
+		}
+		clientIds = append(clientIds, clientId)
+	}
+
+	roles, err := r.RoleService.GetEffectiveUserRoles(ctx, in.UserId, clientIds, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	response := &keycloakext.EffectiveRolesResponse{
+		RealmRoles:  rolesToGRpc(roles.Realm),
+		ClientRoles: make(map[string]*keycloakext.RolesResponse, len(roles.Client)),
+	}
+	for clientId, clientRoles := range roles.Client {
+		response.ClientRoles[clientId] = &keycloakext.RolesResponse{Roles: rolesToGRpc(clientRoles)}
+	}
+
+	return response, nil
+}
+
+func validateCompositeRoles(in *keycloakext.CompositeRolesRequest) error {
+	if in == nil || in.Role == nil || in.Role.Name == "" {
+		return status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	if len(in.Composites) == 0 {
+		return status.Error(codes.InvalidArgument, "composites cannot be nil or empty")
+	}
+
+	for _, composite := range in.Composites {
+		if composite.Name == "" {
+			return status.Error(codes.InvalidArgument, "composite role name cannot be nil or empty")
+		}
+	}
+
+	return nil
+}
+
+// roleClientUuid returns the internal id of the role's client, or an empty id for a realm role.
+func (r RoleController) roleClientUuid(ctx context.Context, ref *keycloakext.RoleRef, token string) (string, error) {
+	if ref.Client == nil {
+		return "", nil
+	}
+
+	if missingClient(ref.Client) {
+		return "", status.Error(codes.InvalidArgument, "client id or clientId cannot be nil or empty")
+	}
+
+	return r.clientUuid(ctx, ref.Client, token)
+}
+
+// rolesByRef looks up the roles, as Keycloak needs their ids to change composites.
+func (r RoleController) rolesByRef(ctx context.Context, refs []*keycloakext.RoleRef, token string) ([]domain.Role, error) {
+	roles := make([]domain.Role, 0, len(refs))
+	for _, ref := range refs {
+		clientId, err := r.roleClientUuid(ctx, ref, token)
+		if err != nil {
+			return nil, err
+		}
+
+		var role domain.Role
+		if clientId == "" {
+			role, err = r.RoleService.GetRealmRole(ctx, ref.Name, token)
+		} else {
+			role, err = r.RoleService.GetClientRole(ctx, clientId, ref.Name, token)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
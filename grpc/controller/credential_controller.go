@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (u UserController) ListUserCredentials(ctx context.Context, in *wrappers.StringValue) (*keycloakext.CredentialsResponse, error) {
+	if in == nil || in.Value == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id cannot be nil or empty")
+	}
+
+	token, err := u.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	credentials, err := u.UserService.GetUserCredentials(ctx, in.Value, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	response := &keycloakext.CredentialsResponse{}
+	for _, credential := range credentials {
+		response.Credentials = append(response.Credentials, credential.StoredCredentialToGRpcResponse())
+	}
+
+	return response, nil
+}
+
+func (u UserController) DeleteUserCredential(ctx context.Context, in *keycloakext.UserCredentialRequest) (*empty.Empty, error) {
+	if err := validateUserCredential(in.GetUserId(), in.GetCredentialId()); err != nil {
+		return nil, err
+	}
+
+	token, err := u.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = u.UserService.DeleteUserCredential(ctx, in.UserId, in.CredentialId, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (u UserController) SetCredentialLabel(ctx context.Context, in *keycloakext.SetCredentialLabelRequest) (*empty.Empty, error) {
+	if err := validateUserCredential(in.GetUserId(), in.GetCredentialId()); err != nil {
+		return nil, err
+	}
+
+	if in.Label == "" {
+		return nil, status.Error(codes.InvalidArgument, "label cannot be nil or empty")
+	}
+
+	token, err := u.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = u.UserService.SetCredentialLabel(ctx, in.UserId, in.CredentialId, in.Label, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (u UserController) MoveCredential(ctx context.Context, in *keycloakext.MoveCredentialRequest) (*empty.Empty, error) {
+	if err := validateUserCredential(in.GetUserId(), in.GetCredentialId()); err != nil {
+		return nil, err
+	}
+
+	if in.After == in.CredentialId {
+		return nil, status.Error(codes.InvalidArgument, "a credential cannot be moved after itself")
+	}
+
+	token, err := u.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = u.UserService.MoveCredential(ctx, in.UserId, in.CredentialId, in.After, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func validateUserCredential(userId, credentialId string) error {
+	if userId == "" {
+		return status.Error(codes.InvalidArgument, "userId cannot be nil or empty")
+	}
+
+	if credentialId == "" {
+		return status.Error(codes.InvalidArgument, "credentialId cannot be nil or empty")
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/hub1989/keycloak-grpc-service/keycloak"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net"
+	"net/url"
+	"strings"
+)
+
+const errorDomain = "keycloak-grpc-service"
+
+// oauthErrorCodes maps the error codes of the OIDC endpoints (RFC 6749,
+// RFC 6750 and RFC 7009), which their HTTP status alone does not tell apart.
+var oauthErrorCodes = map[string]codes.Code{
+	"invalid_request":         codes.InvalidArgument,
+	"invalid_scope":           codes.InvalidArgument,
+	"unsupported_grant_type":  codes.InvalidArgument,
+	"unsupported_token_type":  codes.InvalidArgument,
+	"invalid_grant":           codes.Unauthenticated,
+	"invalid_client":          codes.Unauthenticated,
+	"invalid_token":           codes.Unauthenticated,
+	"unauthorized_client":     codes.PermissionDenied,
+	"access_denied":           codes.PermissionDenied,
+	"temporarily_unavailable": codes.Unavailable,
+	"server_error":            codes.Unavailable,
+}
+
+// keycloakError translates an error returned by the keycloak services into a
+// gRPC status, so callers can branch on the code and details instead of the message.
+func keycloakError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if !errors.Is(err, keycloak.ErrServiceAccount) &&
+		(errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+		return status.FromContextError(err).Err()
+	}
+
+	code := errorCode(err)
+	info := &errdetails.ErrorInfo{
+		Reason: errorReason(err),
+		Domain: errorDomain,
+	}
+	details := []proto.Message{info}
+
+	var keycloakErr *keycloak.Error
+	if errors.As(err, &keycloakErr) {
+		info.Metadata = map[string]string{
+			"operation":   keycloakErr.Op,
+			"http_status": fmt.Sprint(keycloakErr.StatusCode),
+		}
+		if keycloakErr.Code != "" {
+			info.Metadata["keycloak_error"] = keycloakErr.Code
+		}
+
+		if code == codes.InvalidArgument {
+			field := keycloakErr.Field
+			if field == "" {
+				field = keycloakErr.Code
+			}
+			details = append(details, &errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+					Field:       field,
+					Description: keycloakErr.Message,
+				}},
+			})
+		}
+	}
+
+	message := errorMessage(err, code)
+	st, detailErr := status.New(code, message).WithDetails(details...)
+	if detailErr != nil {
+		return status.Error(code, message)
+	}
+	return st.Err()
+}
+
+// errorMessage describes the failure without repeating what Keycloak or the
+// transport said, which can name internal hosts and realm details. Both are
+// logged where the request fails.
+func errorMessage(err error, code codes.Code) string {
+	switch {
+	case errors.Is(err, keycloak.ErrServiceAccount):
+		return "the service could not authenticate with keycloak"
+	case code == codes.Unavailable:
+		return "keycloak is unavailable"
+	}
+
+	var keycloakErr *keycloak.Error
+	if errors.As(err, &keycloakErr) {
+		return "could not " + keycloakErr.Op
+	}
+	return err.Error()
+}
+
+func errorCode(err error) codes.Code {
+	// the caller cannot fix the service's own credentials, so they never map to Unauthenticated
+	if errors.Is(err, keycloak.ErrServiceAccount) {
+		if errors.Is(err, keycloak.ErrUnavailable) || isTransportError(err) || errors.Is(err, context.DeadlineExceeded) {
+			return codes.Unavailable
+		}
+		return codes.Internal
+	}
+
+	if code, ok := oauthErrorCode(err); ok {
+		return code
+	}
+
+	switch {
+	case errors.Is(err, keycloak.ErrConflict):
+		return codes.AlreadyExists
+	case errors.Is(err, keycloak.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, keycloak.ErrValidationFailed):
+		return codes.InvalidArgument
+	case errors.Is(err, keycloak.ErrForbidden):
+		return codes.PermissionDenied
+	case errors.Is(err, keycloak.ErrUnauthorized):
+		return codes.Unauthenticated
+	case errors.Is(err, keycloak.ErrUnavailable), isTransportError(err):
+		return codes.Unavailable
+	}
+	return codes.Internal
+}
+
+func errorReason(err error) string {
+	if errors.Is(err, keycloak.ErrServiceAccount) {
+		return "KEYCLOAK_SERVICE_ACCOUNT"
+	}
+
+	var keycloakErr *keycloak.Error
+	if _, ok := oauthErrorCode(err); ok && errors.As(err, &keycloakErr) {
+		return "KEYCLOAK_" + strings.ToUpper(keycloakErr.Code)
+	}
+
+	switch {
+	case errors.Is(err, keycloak.ErrConflict):
+		return "KEYCLOAK_CONFLICT"
+	case errors.Is(err, keycloak.ErrNotFound):
+		return "KEYCLOAK_NOT_FOUND"
+	case errors.Is(err, keycloak.ErrValidationFailed):
+		return "KEYCLOAK_VALIDATION_FAILED"
+	case errors.Is(err, keycloak.ErrForbidden):
+		return "KEYCLOAK_FORBIDDEN"
+	case errors.Is(err, keycloak.ErrUnauthorized):
+		return "KEYCLOAK_UNAUTHORIZED"
+	case errors.Is(err, keycloak.ErrUnavailable), isTransportError(err):
+		return "KEYCLOAK_UNAVAILABLE"
+	}
+	return "KEYCLOAK_ERROR"
+}
+
+// oauthErrorCode reads the status from the error code the OIDC endpoints send.
+// invalid_grant also covers users who may not sign in yet, which only the
+// description tells apart.
+func oauthErrorCode(err error) (codes.Code, bool) {
+	var keycloakErr *keycloak.Error
+	if !errors.As(err, &keycloakErr) {
+		return codes.OK, false
+	}
+
+	code, ok := oauthErrorCodes[keycloakErr.Code]
+	if !ok {
+		return codes.OK, false
+	}
+
+	if keycloakErr.Code == "invalid_grant" {
+		switch keycloakErr.Message {
+		case "Account is not fully set up":
+			return codes.FailedPrecondition, true
+		case "Account disabled", "Account temporarily disabled":
+			return codes.PermissionDenied, true
+		}
+	}
+	return code, true
+}
+
+// isTransportError reports whether Keycloak could not be reached at all or timed out.
+func isTransportError(err error) bool {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hub1989/keycloak-grpc-service/keycloak"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// rejectedClient returns a configuration for a Keycloak that refuses every
+// client with invalid_client.
+func rejectedClient(t *testing.T) keycloak.DefaultKeycloakConfiguration {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"Invalid client or Invalid client credentials"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return keycloak.DefaultKeycloakConfiguration{BaseURL: server.URL, Realm: "test", Client: server.Client()}
+}
+
+func TestKeycloakError(t *testing.T) {
+	transportErr := &url.Error{Op: "Post", URL: "http://keycloak.internal:8080", Err: errors.New("connection refused")}
+
+	configuration := rejectedClient(t)
+	callerClient := keycloak.ClientCredentials{ClientId: "app", ClientSecret: "wrong"}
+	_, refreshErr := keycloak.DefaultTokenService{Configuration: configuration}.RefreshToken(context.Background(), "refresh", "", callerClient)
+	_, authenticateErr := keycloak.DefaultUserService{Configuration: configuration}.Authenticate(context.Background(), "user", "password", callerClient.ClientId, callerClient.ClientSecret)
+
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"not found", &keycloak.Error{Op: "get user", StatusCode: 404, Message: "User not found"}, codes.NotFound},
+		{"caller credentials", &keycloak.Error{Op: "authenticate user", StatusCode: 401, Code: "invalid_grant", Message: "Invalid user credentials"}, codes.Unauthenticated},
+		{"service token rejected", fmt.Errorf("%w: %w", keycloak.ErrServiceAccount, &keycloak.Error{Op: "get user", StatusCode: 401}), codes.Internal},
+		{"caller client rejected on refresh", refreshErr, codes.Unauthenticated},
+		{"caller client rejected on authenticate", authenticateErr, codes.Unauthenticated},
+		{"service client rejected", fmt.Errorf("%w: %w", keycloak.ErrServiceAccount, &keycloak.Error{Op: "get access token for operation", StatusCode: 401, Code: "invalid_client"}), codes.Internal},
+		{"service token unreachable", fmt.Errorf("%w: %w", keycloak.ErrServiceAccount, transportErr), codes.Unavailable},
+		{"service token timed out", fmt.Errorf("%w: %w", keycloak.ErrServiceAccount, context.DeadlineExceeded), codes.Unavailable},
+		{"transport", transportErr, codes.Unavailable},
+		{"caller cancelled", context.Canceled, codes.Canceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, _ := status.FromError(keycloakError(tt.err))
+			if st.Code() != tt.want {
+				t.Errorf("code = %v, want %v", st.Code(), tt.want)
+			}
+		})
+	}
+}
+
+func TestKeycloakErrorHidesKeycloakMessage(t *testing.T) {
+	err := &keycloak.Error{Op: "get user", StatusCode: 500, Message: "java.lang.NullPointerException at realm master"}
+
+	st, _ := status.FromError(keycloakError(err))
+	if strings.Contains(st.Message(), "NullPointerException") {
+		t.Errorf("message %q repeats the Keycloak error", st.Message())
+	}
+
+	st, _ = status.FromError(keycloakError(&keycloak.Error{Op: "get user", StatusCode: 404, Message: "User not found"}))
+	if st.Message() != "could not get user" {
+		t.Errorf("message = %q, want %q", st.Message(), "could not get user")
+	}
+}
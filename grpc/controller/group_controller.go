@@ -26,12 +26,12 @@ func (g GroupController) CreateGroup(ctx context.Context, in *user.GroupRequest)
 
 	token, err := g.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	err = g.GroupService.CreateGroup(ctx, request, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	return &user.GroupResponse{
@@ -42,12 +42,12 @@ func (g GroupController) CreateGroup(ctx context.Context, in *user.GroupRequest)
 func (g GroupController) GetGroupsInRealm(ctx context.Context, in *wrappers.StringValue) (*user.GroupsResponse, error) {
 	token, err := g.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	resp, err := g.GroupService.GetGroupsInRealm(ctx, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	var groups []*user.GroupResponse
@@ -67,12 +67,12 @@ func (g GroupController) GetGroupById(ctx context.Context, in *wrappers.StringVa
 
 	token, err := g.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	resp, err := g.GroupService.GetGroupById(ctx, in.Value, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	group := resp.GroupToGRpcResponse()
@@ -86,12 +86,12 @@ func (g GroupController) DeleteGroup(ctx context.Context, in *wrappers.StringVal
 
 	token, err := g.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	err = g.GroupService.DeleteGroup(ctx, in.Value, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	return &empty.Empty{}, nil
@@ -104,12 +104,12 @@ func (g GroupController) GetGroupMembers(ctx context.Context, in *wrappers.Strin
 
 	token, err := g.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	resp, err := g.GroupService.GetGroupMembers(ctx, in.Value, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	var users []*user.UserResponse
@@ -138,12 +138,12 @@ func (g GroupController) AddRoleToGroup(ctx context.Context, in *user.RoleGroupR
 
 	token, err := g.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	err = g.GroupService.AddRoleToGroup(ctx, domain.Role{Name: in.Role.Name.Value}, in.GroupId, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	return &empty.Empty{}, nil
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"encoding/base64"
+	"strconv"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// page resolves the offset and size of a list request. A page token, when
+// present, takes precedence over the explicit offset.
+func page(first, max int32, pageToken string) (int, int, bool) {
+	offset := int(first)
+	if pageToken != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return 0, 0, false
+		}
+		offset, err = strconv.Atoi(string(decoded))
+		if err != nil {
+			return 0, 0, false
+		}
+	}
+
+	if offset < 0 || max < 0 {
+		return 0, 0, false
+	}
+
+	size := int(max)
+	if size == 0 {
+		size = defaultPageSize
+	}
+	if size > maxPageSize {
+		size = maxPageSize
+	}
+
+	return offset, size, true
+}
+
+// nextPageToken returns an empty token once a page comes back short, as Keycloak has no more results.
+func nextPageToken(offset, size, returned int) string {
+	if returned < size {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset + returned)))
+}
@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+func (u UserController) ListRequiredActions(ctx context.Context, in *empty.Empty) (*keycloakext.RequiredActionsResponse, error) {
+	token, err := u.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	actions, err := u.UserService.GetRequiredActions(ctx, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	response := &keycloakext.RequiredActionsResponse{}
+	for _, action := range actions {
+		response.Actions = append(response.Actions, action.RequiredActionToGRpcResponse())
+	}
+
+	return response, nil
+}
+
+func (u UserController) SetRequiredActions(ctx context.Context, in *keycloakext.RequiredActionsRequest) (*empty.Empty, error) {
+	if in == nil || in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "userId cannot be nil or empty")
+	}
+
+	token, err := u.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	if err = u.validateRequiredActions(ctx, in.Actions, token.AccessToken); err != nil {
+		return nil, err
+	}
+
+	// without a version the actions are simply re-applied to a user that
+	// changed during the update, as they replace whatever was there
+	err = u.updateUser(ctx, in.UserId, in.Version, in.Version == "", token.AccessToken, replaceRequiredActions(in.Actions))
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (u UserController) ClearRequiredActions(ctx context.Context, in *wrappers.StringValue) (*empty.Empty, error) {
+	if in == nil || in.Value == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id cannot be nil or empty")
+	}
+
+	token, err := u.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = u.updateUser(ctx, in.Value, "", true, token.AccessToken, replaceRequiredActions(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (u UserController) ExecuteActionsEmail(ctx context.Context, in *keycloakext.ExecuteActionsEmailRequest) (*empty.Empty, error) {
+	if in == nil || in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "userId cannot be nil or empty")
+	}
+
+	if len(in.Actions) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "actions cannot be nil or empty")
+	}
+
+	if in.Lifespan != nil && in.Lifespan.Value <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "lifespan must be positive")
+	}
+
+	email := domain.ActionsEmail{
+		ClientId:    in.ClientId,
+		RedirectUri: in.RedirectUri,
+		Lifespan:    int(in.Lifespan.GetValue()),
+	}
+	if err := validateActionsEmail(email); err != nil {
+		return nil, err
+	}
+
+	token, err := u.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	if err = u.validateRequiredActions(ctx, in.Actions, token.AccessToken); err != nil {
+		return nil, err
+	}
+
+	err = u.UserService.ExecuteActionsEmail(ctx, in.UserId, in.Actions, email, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (u UserController) SendVerifyEmail(ctx context.Context, in *keycloakext.SendVerifyEmailRequest) (*empty.Empty, error) {
+	if in == nil || in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "userId cannot be nil or empty")
+	}
+
+	email := domain.ActionsEmail{
+		ClientId:    in.ClientId,
+		RedirectUri: in.RedirectUri,
+	}
+	if err := validateActionsEmail(email); err != nil {
+		return nil, err
+	}
+
+	token, err := u.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = u.UserService.SendVerifyEmail(ctx, in.UserId, email, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// validateRequiredActions rejects actions that are unknown or disabled in the
+// realm, which Keycloak would otherwise accept and never prompt for.
+func (u UserController) validateRequiredActions(ctx context.Context, actions []string, token string) error {
+	if len(actions) == 0 {
+		return nil
+	}
+
+	realmActions, err := u.UserService.GetRequiredActions(ctx, token)
+	if err != nil {
+		return keycloakError(err)
+	}
+
+	if unknown := domain.UnknownRequiredActions(actions, realmActions); len(unknown) > 0 {
+		return status.Errorf(codes.InvalidArgument, "required actions %s are not enabled in the realm", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// replaceRequiredActions sets the user's required actions. Keycloak has no
+// endpoint for them, so the whole user is written back.
+func replaceRequiredActions(actions []string) func(domain.UserRepresentation) (domain.UserRepresentation, error) {
+	return func(user domain.UserRepresentation) (domain.UserRepresentation, error) {
+		// an empty list clears them, nil would leave them untouched
+		user.RequiredActions = append([]string{}, actions...)
+		return user, nil
+	}
+}
+
+func validateActionsEmail(email domain.ActionsEmail) error {
+	if email.RedirectUri != "" && email.ClientId == "" {
+		return status.Error(codes.InvalidArgument, "redirectUri needs a clientId")
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hub1989/keycloak-grpc-service/domain"
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
 	"github.com/hub1989/keycloak-grpc-service/keycloak"
 	user "github.com/hub1989/keycloak-protobuf/golang/keycloak"
 	"google.golang.org/grpc/codes"
@@ -13,8 +14,10 @@ import (
 
 type RoleController struct {
 	user.UnimplementedRoleServiceServer
+	keycloakext.UnimplementedRoleAdminServiceServer
 	keycloak.RoleService
 	keycloak.CredentialService
+	keycloak.ClientService
 }
 
 func (r RoleController) AssignRoleToUser(ctx context.Context, in *user.UserRoleRequest) (*empty.Empty, error) {
@@ -41,12 +44,12 @@ func (r RoleController) AssignRoleToUser(ctx context.Context, in *user.UserRoleR
 
 	token, err := r.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	err = r.RoleService.AssignRoleToUser(ctx, in.UserId, []domain.Role{role}, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	return &empty.Empty{}, nil
@@ -59,12 +62,12 @@ func (r RoleController) GetUserRoles(ctx context.Context, in *wrappers.StringVal
 
 	token, err := r.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	roles, err := r.RoleService.GetUserRoles(ctx, in.Value, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	var response []*user.RoleResponse
@@ -83,12 +86,12 @@ func (r RoleController) GetAvailableRoles(ctx context.Context, in *wrappers.Stri
 
 	token, err := r.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	roles, err := r.RoleService.GetAvailableRoles(ctx, in.Value, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	var response []*user.RoleResponse
@@ -116,7 +119,7 @@ func (r RoleController) RemoveRoleFromUser(ctx context.Context, in *user.UserRol
 
 	token, err := r.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	role := domain.Role{
@@ -129,7 +132,7 @@ func (r RoleController) RemoveRoleFromUser(ctx context.Context, in *user.UserRol
 
 	err = r.RoleService.RemoveRoleFromUser(ctx, in.UserId, []domain.Role{role}, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	return &empty.Empty{}, nil
@@ -150,13 +153,201 @@ func (r RoleController) CreateRole(ctx context.Context, in *user.RoleRequest) (*
 
 	token, err := r.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	err = r.RoleService.CreateRole(ctx, role, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	return &empty.Empty{}, nil
 }
+
+func (r RoleController) ListRealmRoles(ctx context.Context, in *keycloakext.ListRolesRequest) (*keycloakext.RolesPage, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil or empty")
+	}
+
+	first, max, ok := page(in.First, in.Max, in.PageToken)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "first, max or pageToken is invalid")
+	}
+
+	search := domain.RoleSearch{Brief: in.Brief, First: first, Max: max}
+	if in.Search != nil {
+		search.Search = in.Search.Value
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	roles, err := r.RoleService.GetRealmRoles(ctx, search, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &keycloakext.RolesPage{
+		Roles:         rolesToGRpc(roles),
+		NextPageToken: nextPageToken(first, max, len(roles)),
+	}, nil
+}
+
+func (r RoleController) GetRealmRole(ctx context.Context, in *wrappers.StringValue) (*keycloakext.Role, error) {
+	if in == nil || in.Value == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	role, err := r.RoleService.GetRealmRole(ctx, in.Value, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return role.RoleToGRpcRole(), nil
+}
+
+func (r RoleController) CreateRealmRole(ctx context.Context, in *keycloakext.Role) (*keycloakext.Role, error) {
+	if in == nil || in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = r.RoleService.CreateRealmRole(ctx, domain.RoleGRpcRequestToRole(in), token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	role, err := r.RoleService.GetRealmRole(ctx, in.Name, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return role.RoleToGRpcRole(), nil
+}
+
+func (r RoleController) UpdateRealmRole(ctx context.Context, in *keycloakext.UpdateRoleRequest) (*keycloakext.Role, error) {
+	if in == nil || in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	role, err := r.RoleService.GetRealmRole(ctx, in.Name, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	role.UpdateRole(in)
+
+	err = r.RoleService.UpdateRealmRole(ctx, in.Name, role, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return role.RoleToGRpcRole(), nil
+}
+
+func (r RoleController) DeleteRealmRole(ctx context.Context, in *wrappers.StringValue) (*empty.Empty, error) {
+	if in == nil || in.Value == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = r.RoleService.DeleteRealmRole(ctx, in.Value, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r RoleController) ListRealmRoleUsers(ctx context.Context, in *keycloakext.RoleMembersRequest) (*keycloakext.SearchUsersResponse, error) {
+	if in == nil || in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	first, max, ok := page(in.First, in.Max, in.PageToken)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "first, max or pageToken is invalid")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	users, err := r.RoleService.GetRealmRoleUsers(ctx, in.Name, first, max, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	var response []*user.UserResponse
+	for _, representation := range users {
+		gRpcResponse := representation.UserToGRpcResponse()
+		response = append(response, &gRpcResponse)
+	}
+
+	return &keycloakext.SearchUsersResponse{
+		Users:          response,
+		NextPageToken:  nextPageToken(first, max, len(users)),
+		UserAttributes: usersAttributes(users),
+	}, nil
+}
+
+func (r RoleController) ListRealmRoleGroups(ctx context.Context, in *keycloakext.RoleMembersRequest) (*keycloakext.RoleGroupsResponse, error) {
+	if in == nil || in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name cannot be nil or empty")
+	}
+
+	first, max, ok := page(in.First, in.Max, in.PageToken)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "first, max or pageToken is invalid")
+	}
+
+	token, err := r.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	groups, err := r.RoleService.GetRealmRoleGroups(ctx, in.Name, first, max, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	var response []*user.GroupResponse
+	for _, group := range groups {
+		gRpcResponse := group.GroupOverviewToGRpcResponse()
+		response = append(response, &gRpcResponse)
+	}
+
+	return &keycloakext.RoleGroupsResponse{
+		Groups:        response,
+		NextPageToken: nextPageToken(first, max, len(groups)),
+	}, nil
+}
+
+func rolesToGRpc(roles []domain.Role) []*keycloakext.Role {
+	var response []*keycloakext.Role
+	for _, role := range roles {
+		response = append(response, role.RoleToGRpcRole())
+	}
+	return response
+}
@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
+	"github.com/hub1989/keycloak-grpc-service/keycloak"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SessionController struct {
+	keycloakext.UnimplementedSessionAdminServiceServer
+	keycloak.SessionService
+	keycloak.CredentialService
+	keycloak.ClientService
+}
+
+func (s SessionController) ListUserSessions(ctx context.Context, in *keycloakext.ListUserSessionsRequest) (*keycloakext.UserSessionsResponse, error) {
+	if in == nil || in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "userId cannot be nil or empty")
+	}
+
+	token, err := s.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	sessions, err := s.SessionService.GetUserSessions(ctx, in.UserId, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	if in.Offline {
+		var offline []domain.UserSession
+		if missingClient(in.Client) {
+			offline, err = s.SessionService.GetAllUserOfflineSessions(ctx, in.UserId, token.AccessToken)
+		} else {
+			var clientId string
+			clientId, err = s.clientUuid(ctx, in.Client, token.AccessToken)
+			if err == nil {
+				offline, err = s.SessionService.GetUserOfflineSessions(ctx, in.UserId, clientId, token.AccessToken)
+			}
+		}
+		if err != nil {
+			return nil, keycloakError(err)
+		}
+		sessions = append(sessions, offline...)
+	}
+
+	response := &keycloakext.UserSessionsResponse{}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, session.UserSessionToGRpcResponse())
+	}
+
+	return response, nil
+}
+
+func (s SessionController) RevokeSession(ctx context.Context, in *keycloakext.RevokeSessionRequest) (*empty.Empty, error) {
+	if in == nil || in.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "sessionId cannot be nil or empty")
+	}
+
+	token, err := s.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = s.SessionService.DeleteSession(ctx, in.SessionId, in.Offline, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s SessionController) LogoutUser(ctx context.Context, in *wrappers.StringValue) (*empty.Empty, error) {
+	if in == nil || in.Value == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id cannot be nil or empty")
+	}
+
+	token, err := s.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = s.SessionService.LogoutUser(ctx, in.Value, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s SessionController) RevokeOfflineTokens(ctx context.Context, in *keycloakext.RevokeOfflineTokensRequest) (*empty.Empty, error) {
+	if in == nil || in.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "userId cannot be nil or empty")
+	}
+
+	if missingClient(in.Client) {
+		return nil, status.Error(codes.InvalidArgument, "client cannot be nil or empty")
+	}
+
+	token, err := s.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	// the consent endpoint takes the clientId, not the internal id
+	clientId := in.Client.GetClientId()
+	if clientId == "" {
+		client, err := s.ClientService.GetClientById(ctx, in.Client.GetId(), token.AccessToken)
+		if err != nil {
+			return nil, keycloakError(err)
+		}
+		clientId = client.ClientId
+	}
+
+	err = s.SessionService.RevokeUserConsent(ctx, in.UserId, clientId, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s SessionController) clientUuid(ctx context.Context, ref *keycloakext.ClientRef, token string) (string, error) {
+	if ref.GetId() != "" {
+		return ref.GetId(), nil
+	}
+
+	client, err := s.ClientService.GetClientByClientId(ctx, ref.GetClientId(), token)
+	if err != nil {
+		return "", err
+	}
+	return client.Id, nil
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
+	"github.com/hub1989/keycloak-grpc-service/keycloak"
+	user "github.com/hub1989/keycloak-protobuf/golang/keycloak"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var tokenTypeHints = map[string]bool{
+	"":              true,
+	"access_token":  true,
+	"refresh_token": true,
+}
+
+type TokenController struct {
+	keycloakext.UnimplementedTokenServiceServer
+	keycloak.TokenService
+	keycloak.TokenVerifier
+}
+
+func (t TokenController) RefreshToken(ctx context.Context, in *keycloakext.RefreshTokenRequest) (*user.AccessTokenResponse, error) {
+	if in == nil || in.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refreshToken cannot be nil or empty")
+	}
+
+	if in.ClientId == "" {
+		return nil, status.Error(codes.InvalidArgument, "clientId cannot be nil or empty")
+	}
+
+	client := keycloak.ClientCredentials{ClientId: in.ClientId, ClientSecret: in.ClientSecret}
+	resp, err := t.TokenService.RefreshToken(ctx, in.RefreshToken, in.Scope, client)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	gRpc := resp.AccessTokenToGRpcResponse()
+	return &gRpc, nil
+}
+
+func (t TokenController) Logout(ctx context.Context, in *keycloakext.LogoutRequest) (*empty.Empty, error) {
+	if in == nil || in.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refreshToken cannot be nil or empty")
+	}
+
+	if in.ClientId == "" {
+		return nil, status.Error(codes.InvalidArgument, "clientId cannot be nil or empty")
+	}
+
+	client := keycloak.ClientCredentials{ClientId: in.ClientId, ClientSecret: in.ClientSecret}
+	if err := t.TokenService.Logout(ctx, in.RefreshToken, client); err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (t TokenController) RevokeToken(ctx context.Context, in *keycloakext.RevokeTokenRequest) (*empty.Empty, error) {
+	if in == nil || in.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token cannot be nil or empty")
+	}
+
+	if in.ClientId == "" {
+		return nil, status.Error(codes.InvalidArgument, "clientId cannot be nil or empty")
+	}
+
+	if !tokenTypeHints[in.TokenTypeHint] {
+		return nil, status.Error(codes.InvalidArgument, "tokenTypeHint must be access_token or refresh_token")
+	}
+
+	client := keycloak.ClientCredentials{ClientId: in.ClientId, ClientSecret: in.ClientSecret}
+	if err := t.TokenService.RevokeToken(ctx, in.Token, in.TokenTypeHint, client); err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (t TokenController) ValidateToken(ctx context.Context, in *keycloakext.ValidateTokenRequest) (*keycloakext.ValidateTokenResponse, error) {
+	if in == nil || in.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token cannot be nil or empty")
+	}
+
+	claims, err := t.TokenVerifier.VerifyTokenForAudience(ctx, in.Token, in.Audience)
+	if err != nil {
+		// the token cannot be judged while the realm keys are unreachable
+		var keycloakErr *keycloak.Error
+		if errors.As(err, &keycloakErr) || isTransportError(err) {
+			return nil, keycloakError(err)
+		}
+		return &keycloakext.ValidateTokenResponse{Reason: err.Error()}, nil
+	}
+
+	if in.Introspect {
+		var active bool
+		claims, active, err = t.TokenService.IntrospectToken(ctx, in.Token)
+		if err != nil {
+			return nil, keycloakError(err)
+		}
+		if !active {
+			return &keycloakext.ValidateTokenResponse{Reason: "token is not active"}, nil
+		}
+	}
+
+	return claims.TokenClaimsToGRpcResponse(), nil
+}
+
+func (t TokenController) UserInfo(ctx context.Context, in *keycloakext.UserInfoRequest) (*keycloakext.UserInfoResponse, error) {
+	if in == nil || in.AccessToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "accessToken cannot be nil or empty")
+	}
+
+	userInfo, err := t.TokenService.GetUserInfo(ctx, in.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return userInfo.OIDCInfoToGRpcResponse(), nil
+}
@@ -2,10 +2,10 @@ package controller
 
 import (
 	"context"
-	"fmt"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hub1989/keycloak-grpc-service/domain"
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
 	"github.com/hub1989/keycloak-grpc-service/keycloak"
 	user "github.com/hub1989/keycloak-protobuf/golang/keycloak"
 	"google.golang.org/grpc/codes"
@@ -14,6 +14,8 @@ import (
 
 type UserController struct {
 	user.UnimplementedUserServiceServer
+	keycloakext.UnimplementedUserAdminServiceServer
+	keycloakext.UnimplementedCredentialAdminServiceServer
 	keycloak.CredentialService
 	keycloak.UserService
 }
@@ -25,15 +27,14 @@ func (u UserController) CreateUser(ctx context.Context, in *user.UserRequest) (*
 
 	request := domain.UserGRpcRequestToUser(in)
 
-	fmt.Println(request)
 	token, err := u.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	err = u.UserService.CreateUser(ctx, request, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	return &empty.Empty{}, nil
@@ -47,24 +48,106 @@ func (u UserController) UpdateUser(ctx context.Context, in *user.UpdateUserReque
 	token, err := u.CredentialService.ObtainTokenForOps(ctx)
 
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	user, err := u.UserService.GetUserById(ctx, in.Pid, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	request := user.UpdateUser(in)
 
 	err = u.UserService.UpdateUser(ctx, request, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	return &empty.Empty{}, nil
 }
 
+// userUpdateRetries bounds how often a read-modify-write update is
+// re-applied to a user that keeps changing underneath it.
+const userUpdateRetries = 3
+
+func (u UserController) PatchUser(ctx context.Context, in *keycloakext.PatchUserRequest) (*empty.Empty, error) {
+	if in == nil || in.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id cannot be nil or empty")
+	}
+
+	paths := in.UpdateMask.GetPaths()
+	if len(paths) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "updateMask cannot be nil or empty")
+	}
+
+	if in.RetryAttributeMerge {
+		if in.Version != "" {
+			return nil, status.Error(codes.InvalidArgument, "version and retryAttributeMerge cannot be combined")
+		}
+		if !domain.MergesAttributes(paths) {
+			return nil, status.Error(codes.InvalidArgument, "retryAttributeMerge needs an updateMask of attributes.<name> paths")
+		}
+	}
+
+	token, err := u.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	err = u.updateUser(ctx, in.Id, in.Version, in.RetryAttributeMerge, token.AccessToken, func(current domain.UserRepresentation) (domain.UserRepresentation, error) {
+		return current.PatchUser(in.User, paths)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// updateUser writes back the result of change applied to the stored user.
+// When version is set the update fails with ABORTED unless the user still
+// matches it. When retry is set, change is re-applied to the latest user
+// instead of failing if the user changes during the update.
+func (u UserController) updateUser(ctx context.Context, id, version string, retry bool, token string, change func(domain.UserRepresentation) (domain.UserRepresentation, error)) error {
+	for attempt := 0; ; attempt++ {
+		current, err := u.UserService.GetUserById(ctx, id, token)
+		if err != nil {
+			return keycloakError(err)
+		}
+
+		if version != "" && current.Version() != version {
+			return status.Errorf(codes.Aborted, "user %s changed since version %s", id, version)
+		}
+
+		request, err := change(current)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// keycloak cannot make the write conditional, so check again right
+		// before it to keep the window for a lost update small
+		if version != "" || retry {
+			latest, err := u.UserService.GetUserById(ctx, id, token)
+			if err != nil {
+				return keycloakError(err)
+			}
+
+			if latest.Version() != current.Version() {
+				if !retry || attempt == userUpdateRetries {
+					return status.Errorf(codes.Aborted, "user %s changed during the update", id)
+				}
+				continue
+			}
+		}
+
+		if err = u.UserService.UpdateUser(ctx, request, token); err != nil {
+			return keycloakError(err)
+		}
+
+		return nil
+	}
+}
+
 func (u UserController) GetUserById(ctx context.Context, in *wrappers.StringValue) (*user.UserResponse, error) {
 	if in == nil {
 		return nil, status.Error(codes.InvalidArgument, "id cannot be nil or empty")
@@ -76,12 +159,12 @@ func (u UserController) GetUserById(ctx context.Context, in *wrappers.StringValu
 
 	token, err := u.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	resp, err := u.UserService.GetUserById(ctx, in.Value, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, keycloakError(err)
 	}
 	grpcResp := resp.UserToGRpcResponse()
 
@@ -99,12 +182,12 @@ func (u UserController) GetUserByUsername(ctx context.Context, in *wrappers.Stri
 
 	token, err := u.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	resp, err := u.UserService.GetUserByUsername(ctx, in.Value, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, keycloakError(err)
 	}
 	grpcResp := resp.UserToGRpcResponse()
 
@@ -122,12 +205,12 @@ func (u UserController) DeleteUser(ctx context.Context, in *wrappers.StringValue
 
 	token, err := u.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	err = u.UserService.DeleteUser(ctx, in.Value, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 	return &empty.Empty{}, nil
 }
@@ -147,12 +230,12 @@ func (u UserController) AddUserToGroup(ctx context.Context, in *user.UserGroupRe
 
 	token, err := u.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	err = u.UserService.AddUserToGroup(ctx, in.UserId, in.GroupId, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 	return &empty.Empty{}, nil
 }
@@ -172,12 +255,12 @@ func (u UserController) RemoveUserFromGroup(ctx context.Context, in *user.UserGr
 
 	token, err := u.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	err = u.UserService.RemoveUserFromGroup(ctx, in.UserId, in.GroupId, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	return &empty.Empty{}, nil
@@ -212,7 +295,7 @@ func (u UserController) Authenticate(ctx context.Context, in *user.AuthenticateR
 
 	resp, err := u.UserService.Authenticate(ctx, in.Username, in.Password, in.ClientId.Value, clientSecret)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	gRpc := resp.AccessTokenToGRpcResponse()
@@ -222,12 +305,12 @@ func (u UserController) Authenticate(ctx context.Context, in *user.AuthenticateR
 func (u UserController) GetAllUsers(ctx context.Context, in *empty.Empty) (*user.UsersResponse, error) {
 	token, err := u.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	users, err := u.UserService.GetAllUsers(ctx, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	var resp []*user.UserResponse
@@ -241,24 +324,28 @@ func (u UserController) GetAllUsers(ctx context.Context, in *empty.Empty) (*user
 	}, nil
 }
 
-func (u UserController) GetUsersByIds(ctx context.Context, in *user.StringsRequest) (*user.UsersResponse, error) {
-	if in == nil || len(in.Requests) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "userIds cannot be nil or empty")
+func (u UserController) SearchUsers(ctx context.Context, in *keycloakext.SearchUsersRequest) (*keycloakext.SearchUsersResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil or empty")
 	}
-	var ids []string
 
-	for _, request := range in.Requests {
-		ids = append(ids, request)
+	first, max, ok := page(in.First, in.Max, in.PageToken)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "first, max or pageToken is invalid")
 	}
 
+	search := domain.UserSearchGRpcRequestToSearch(in)
+	search.First = first
+	search.Max = max
+
 	token, err := u.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
-	users, err := u.UserService.GetUsersByIds(ctx, ids, token.AccessToken)
+	users, err := u.UserService.SearchUsers(ctx, search, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	var resp []*user.UserResponse
@@ -267,42 +354,198 @@ func (u UserController) GetUsersByIds(ctx context.Context, in *user.StringsReque
 		resp = append(resp, &gRpcResponse)
 	}
 
+	memberships, err := u.expandUsers(ctx, resp, domain.UserExpandGRpcRequestToExpand(in.Expand), token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &keycloakext.SearchUsersResponse{
+		Users:          resp,
+		NextPageToken:  nextPageToken(first, max, len(users)),
+		Memberships:    memberships,
+		UserAttributes: usersAttributes(users),
+	}, nil
+}
+
+func (u UserController) CountUsers(ctx context.Context, in *keycloakext.SearchUsersRequest) (*wrappers.Int32Value, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil or empty")
+	}
+
+	token, err := u.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	count, err := u.UserService.CountUsers(ctx, domain.UserSearchGRpcRequestToSearch(in), token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &wrappers.Int32Value{Value: int32(count)}, nil
+}
+
+func (u UserController) GetUsersByIds(ctx context.Context, in *user.StringsRequest) (*user.UsersResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "userIds cannot be nil or empty")
+	}
+
+	resp, err := u.BatchGetUsersByIds(ctx, &keycloakext.BatchGetUsersRequest{Requests: in.Requests})
+	if err != nil {
+		return nil, err
+	}
+
 	return &user.UsersResponse{
-		Users: resp,
+		Users: resp.Users,
 	}, nil
 }
 
 func (u UserController) GetUsersByUsernames(ctx context.Context, in *user.StringsRequest) (*user.UsersResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "usernames cannot be nil or empty")
+	}
+
+	resp, err := u.BatchGetUsersByUsernames(ctx, &keycloakext.BatchGetUsersRequest{Requests: in.Requests})
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.UsersResponse{
+		Users: resp.Users,
+	}, nil
+}
+
+func (u UserController) BatchGetUsersByIds(ctx context.Context, in *keycloakext.BatchGetUsersRequest) (*keycloakext.BatchUsersResponse, error) {
+	if in == nil || len(in.Requests) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "userIds cannot be nil or empty")
+	}
+
+	token, err := u.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	users, notFound, err := u.UserService.GetUsersByIds(ctx, in.Requests, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return u.batchUsersResponse(ctx, users, notFound, in.Expand, token.AccessToken)
+}
+
+func (u UserController) BatchGetUsersByUsernames(ctx context.Context, in *keycloakext.BatchGetUsersRequest) (*keycloakext.BatchUsersResponse, error) {
 	if in == nil || len(in.Requests) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "usernames cannot be nil or empty")
 	}
-	var usernames []string
 
-	for _, request := range in.Requests {
-		usernames = append(usernames, request)
+	token, err := u.CredentialService.ObtainTokenForOps(ctx)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	users, notFound, err := u.UserService.GetUsersByUsernames(ctx, in.Requests, token.AccessToken)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return u.batchUsersResponse(ctx, users, notFound, in.Expand, token.AccessToken)
+}
+
+func (u UserController) GetUser(ctx context.Context, in *keycloakext.GetUserRequest) (*keycloakext.UserDetails, error) {
+	if in == nil || (in.GetId() == "" && in.GetUsername() == "") {
+		return nil, status.Error(codes.InvalidArgument, "id or username cannot be nil or empty")
 	}
 
 	token, err := u.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
+	}
+
+	var representation domain.UserRepresentation
+	if in.GetId() != "" {
+		representation, err = u.UserService.GetUserById(ctx, in.GetId(), token.AccessToken)
+	} else {
+		representation, err = u.UserService.GetUserByUsername(ctx, in.GetUsername(), token.AccessToken)
+	}
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	gRpcResponse := representation.UserToGRpcResponse()
+	details := &keycloakext.UserDetails{
+		User:            &gRpcResponse,
+		Attributes:      domain.AttributesToGRpc(representation.Attributes),
+		Version:         representation.Version(),
+		RequiredActions: representation.RequiredActions,
 	}
 
-	users, err := u.UserService.GetUsersByUsernames(ctx, usernames, token.AccessToken)
+	memberships, err := u.expandUsers(ctx, []*user.UserResponse{details.User}, domain.UserExpandGRpcRequestToExpand(in.Expand), token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
+	}
+	if len(memberships) > 0 {
+		details.Memberships = memberships[0]
 	}
 
+	return details, nil
+}
+
+func (u UserController) batchUsersResponse(ctx context.Context, users []domain.UserRepresentation, notFound []string, expand *keycloakext.UserExpand, token string) (*keycloakext.BatchUsersResponse, error) {
 	var resp []*user.UserResponse
 	for _, representation := range users {
 		gRpcResponse := representation.UserToGRpcResponse()
 		resp = append(resp, &gRpcResponse)
 	}
 
-	return &user.UsersResponse{
-		Users: resp,
+	memberships, err := u.expandUsers(ctx, resp, domain.UserExpandGRpcRequestToExpand(expand), token)
+	if err != nil {
+		return nil, keycloakError(err)
+	}
+
+	return &keycloakext.BatchUsersResponse{
+		Users:          resp,
+		NotFound:       notFound,
+		Memberships:    memberships,
+		UserAttributes: usersAttributes(users),
 	}, nil
 }
 
+// usersAttributes keeps every attribute value, UserResponse only has room for the first.
+func usersAttributes(users []domain.UserRepresentation) []*keycloakext.UserAttributes {
+	response := make([]*keycloakext.UserAttributes, len(users))
+	for i, representation := range users {
+		response[i] = representation.UserAttributesToGRpcResponse()
+	}
+	return response
+}
+
+// expandUsers looks up the requested memberships and sets the realm roles on the users.
+func (u UserController) expandUsers(ctx context.Context, users []*user.UserResponse, expand domain.UserExpand, token string) ([]*keycloakext.UserMemberships, error) {
+	if !expand.Any() || len(users) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(users))
+	for i, gRpcResponse := range users {
+		ids[i] = gRpcResponse.Sub
+	}
+
+	memberships, err := u.UserService.GetUserMemberships(ctx, ids, expand, token)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*keycloakext.UserMemberships, len(memberships))
+	for i, membership := range memberships {
+		if expand.Roles {
+			users[i].Roles = membership.RealmRoles
+		}
+		response[i] = membership.MembershipsToGRpcResponse()
+	}
+
+	return response, nil
+}
+
 func (u UserController) SetUserPassword(ctx context.Context, in *user.PasswordRequest) (*wrappers.BoolValue, error) {
 	if in == nil {
 		return nil, status.Error(codes.InvalidArgument, "request cannot be nil or empty")
@@ -318,12 +561,12 @@ func (u UserController) SetUserPassword(ctx context.Context, in *user.PasswordRe
 
 	token, err := u.CredentialService.ObtainTokenForOps(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	resp, err := u.UserService.SetUserPassword(ctx, in.Password, in.UserId, in.Temporary, token.AccessToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, keycloakError(err)
 	}
 
 	return &wrappers.BoolValue{Value: resp}, nil
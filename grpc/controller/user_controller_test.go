@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"context"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"github.com/hub1989/keycloak-grpc-service/keycloak"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+)
+
+// changingUserService serves a user that another writer changes on each of
+// the first changes reads, and records what is written back.
+type changingUserService struct {
+	keycloak.UserService
+	user    domain.UserRepresentation
+	changes int
+	written []domain.UserRepresentation
+}
+
+func (s *changingUserService) GetUserById(ctx context.Context, id string, token string) (domain.UserRepresentation, error) {
+	user := s.user
+	if s.changes > 0 {
+		s.changes--
+		s.user.FirstName += "!"
+	}
+	return user, nil
+}
+
+func (s *changingUserService) UpdateUser(ctx context.Context, request domain.UserRepresentation, token string) error {
+	s.written = append(s.written, request)
+	s.user = request
+	return nil
+}
+
+func TestUpdateUser(t *testing.T) {
+	stored := domain.UserRepresentation{Id: "id", FirstName: "first", RequiredActions: []string{"VERIFY_EMAIL"}}
+
+	tests := []struct {
+		name    string
+		version string
+		retry   bool
+		changes int
+		want    codes.Code
+	}{
+		{"unchanged", "", false, 0, codes.OK},
+		{"matching version", stored.Version(), false, 0, codes.OK},
+		{"stale version", "stale", false, 0, codes.Aborted},
+		{"changed during the update", stored.Version(), false, 1, codes.Aborted},
+		{"retried after a change", "", true, 2, codes.OK},
+		{"changes on every attempt", "", true, 2 * (userUpdateRetries + 1), codes.Aborted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &changingUserService{user: stored, changes: tt.changes}
+			controller := UserController{UserService: service}
+
+			err := controller.updateUser(context.Background(), "id", tt.version, tt.retry, "token", replaceRequiredActions([]string{"UPDATE_PASSWORD"}))
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("updateUser() = %v, want %v", err, tt.want)
+			}
+
+			if tt.want != codes.OK {
+				if len(service.written) != 0 {
+					t.Errorf("wrote %d users, want none", len(service.written))
+				}
+				return
+			}
+			if len(service.written) != 1 {
+				t.Fatalf("wrote %d users, want 1", len(service.written))
+			}
+			written := service.written[0]
+			if !reflect.DeepEqual(written.RequiredActions, []string{"UPDATE_PASSWORD"}) {
+				t.Errorf("required actions = %v, want [UPDATE_PASSWORD]", written.RequiredActions)
+			}
+			// the actions are applied to the latest user, keeping the other writer's change
+			if written.FirstName != service.user.FirstName {
+				t.Errorf("first name = %q, want %q", written.FirstName, service.user.FirstName)
+			}
+		})
+	}
+}
+
+func TestReplaceRequiredActionsClears(t *testing.T) {
+	user, err := replaceRequiredActions(nil)(domain.UserRepresentation{RequiredActions: []string{"VERIFY_EMAIL"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// nil would leave them untouched in keycloak
+	if user.RequiredActions == nil || len(user.RequiredActions) != 0 {
+		t.Errorf("required actions = %#v, want an empty list", user.RequiredActions)
+	}
+}
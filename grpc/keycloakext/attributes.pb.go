@@ -0,0 +1,147 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.12.4
+// source: keycloak/ext/attributes.proto
+
+package keycloakext
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// the values of one keycloak attribute, which are always multi-valued
+type AttributeValues struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *AttributeValues) Reset() {
+	*x = AttributeValues{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_attributes_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValues) ProtoMessage() {}
+
+func (x *AttributeValues) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_attributes_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValues.ProtoReflect.Descriptor instead.
+func (*AttributeValues) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_attributes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttributeValues) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_keycloak_ext_attributes_proto protoreflect.FileDescriptor
+
+var file_keycloak_ext_attributes_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2f, 0x65, 0x78, 0x74, 0x2f, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0c, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x22, 0x29, 0x0a,
+	0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x75, 0x62, 0x31, 0x39, 0x38, 0x39, 0x2f, 0x6b,
+	0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f,
+	0x61, 0x6b, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_keycloak_ext_attributes_proto_rawDescOnce sync.Once
+	file_keycloak_ext_attributes_proto_rawDescData = file_keycloak_ext_attributes_proto_rawDesc
+)
+
+func file_keycloak_ext_attributes_proto_rawDescGZIP() []byte {
+	file_keycloak_ext_attributes_proto_rawDescOnce.Do(func() {
+		file_keycloak_ext_attributes_proto_rawDescData = protoimpl.X.CompressGZIP(file_keycloak_ext_attributes_proto_rawDescData)
+	})
+	return file_keycloak_ext_attributes_proto_rawDescData
+}
+
+var file_keycloak_ext_attributes_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_keycloak_ext_attributes_proto_goTypes = []interface{}{
+	(*AttributeValues)(nil), // 0: keycloak.ext.AttributeValues
+}
+var file_keycloak_ext_attributes_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_keycloak_ext_attributes_proto_init() }
+func file_keycloak_ext_attributes_proto_init() {
+	if File_keycloak_ext_attributes_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_keycloak_ext_attributes_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeValues); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_keycloak_ext_attributes_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_keycloak_ext_attributes_proto_goTypes,
+		DependencyIndexes: file_keycloak_ext_attributes_proto_depIdxs,
+		MessageInfos:      file_keycloak_ext_attributes_proto_msgTypes,
+	}.Build()
+	File_keycloak_ext_attributes_proto = out.File
+	file_keycloak_ext_attributes_proto_rawDesc = nil
+	file_keycloak_ext_attributes_proto_goTypes = nil
+	file_keycloak_ext_attributes_proto_depIdxs = nil
+}
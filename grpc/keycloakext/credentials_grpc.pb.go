@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: keycloak/ext/credentials.proto
+
+package keycloakext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CredentialAdminServiceClient is the client API for CredentialAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CredentialAdminServiceClient interface {
+	// takes the user id, lists credentials by priority
+	ListUserCredentials(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*CredentialsResponse, error)
+	DeleteUserCredential(ctx context.Context, in *UserCredentialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetCredentialLabel(ctx context.Context, in *SetCredentialLabelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// changes the priority of a credential, the first one of a type is used by default
+	MoveCredential(ctx context.Context, in *MoveCredentialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type credentialAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCredentialAdminServiceClient(cc grpc.ClientConnInterface) CredentialAdminServiceClient {
+	return &credentialAdminServiceClient{cc}
+}
+
+func (c *credentialAdminServiceClient) ListUserCredentials(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*CredentialsResponse, error) {
+	out := new(CredentialsResponse)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.CredentialAdminService/ListUserCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialAdminServiceClient) DeleteUserCredential(ctx context.Context, in *UserCredentialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.CredentialAdminService/DeleteUserCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialAdminServiceClient) SetCredentialLabel(ctx context.Context, in *SetCredentialLabelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.CredentialAdminService/SetCredentialLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialAdminServiceClient) MoveCredential(ctx context.Context, in *MoveCredentialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.CredentialAdminService/MoveCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CredentialAdminServiceServer is the server API for CredentialAdminService service.
+// All implementations must embed UnimplementedCredentialAdminServiceServer
+// for forward compatibility
+type CredentialAdminServiceServer interface {
+	// takes the user id, lists credentials by priority
+	ListUserCredentials(context.Context, *wrapperspb.StringValue) (*CredentialsResponse, error)
+	DeleteUserCredential(context.Context, *UserCredentialRequest) (*emptypb.Empty, error)
+	SetCredentialLabel(context.Context, *SetCredentialLabelRequest) (*emptypb.Empty, error)
+	// changes the priority of a credential, the first one of a type is used by default
+	MoveCredential(context.Context, *MoveCredentialRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCredentialAdminServiceServer()
+}
+
+// UnimplementedCredentialAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCredentialAdminServiceServer struct {
+}
+
+func (UnimplementedCredentialAdminServiceServer) ListUserCredentials(context.Context, *wrapperspb.StringValue) (*CredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserCredentials not implemented")
+}
+func (UnimplementedCredentialAdminServiceServer) DeleteUserCredential(context.Context, *UserCredentialRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserCredential not implemented")
+}
+func (UnimplementedCredentialAdminServiceServer) SetCredentialLabel(context.Context, *SetCredentialLabelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCredentialLabel not implemented")
+}
+func (UnimplementedCredentialAdminServiceServer) MoveCredential(context.Context, *MoveCredentialRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCredential not implemented")
+}
+func (UnimplementedCredentialAdminServiceServer) mustEmbedUnimplementedCredentialAdminServiceServer() {
+}
+
+// UnsafeCredentialAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CredentialAdminServiceServer will
+// result in compilation errors.
+type UnsafeCredentialAdminServiceServer interface {
+	mustEmbedUnimplementedCredentialAdminServiceServer()
+}
+
+func RegisterCredentialAdminServiceServer(s grpc.ServiceRegistrar, srv CredentialAdminServiceServer) {
+	s.RegisterService(&CredentialAdminService_ServiceDesc, srv)
+}
+
+func _CredentialAdminService_ListUserCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialAdminServiceServer).ListUserCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.CredentialAdminService/ListUserCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialAdminServiceServer).ListUserCredentials(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialAdminService_DeleteUserCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialAdminServiceServer).DeleteUserCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.CredentialAdminService/DeleteUserCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialAdminServiceServer).DeleteUserCredential(ctx, req.(*UserCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialAdminService_SetCredentialLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCredentialLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialAdminServiceServer).SetCredentialLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.CredentialAdminService/SetCredentialLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialAdminServiceServer).SetCredentialLabel(ctx, req.(*SetCredentialLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialAdminService_MoveCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialAdminServiceServer).MoveCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.CredentialAdminService/MoveCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialAdminServiceServer).MoveCredential(ctx, req.(*MoveCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CredentialAdminService_ServiceDesc is the grpc.ServiceDesc for CredentialAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CredentialAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "keycloak.ext.CredentialAdminService",
+	HandlerType: (*CredentialAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUserCredentials",
+			Handler:    _CredentialAdminService_ListUserCredentials_Handler,
+		},
+		{
+			MethodName: "DeleteUserCredential",
+			Handler:    _CredentialAdminService_DeleteUserCredential_Handler,
+		},
+		{
+			MethodName: "SetCredentialLabel",
+			Handler:    _CredentialAdminService_SetCredentialLabel_Handler,
+		},
+		{
+			MethodName: "MoveCredential",
+			Handler:    _CredentialAdminService_MoveCredential_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "keycloak/ext/credentials.proto",
+}
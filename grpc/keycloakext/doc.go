@@ -0,0 +1,9 @@
+// Package keycloakext holds the gRPC services this service exposes in addition
+// to the ones published in github.com/hub1989/keycloak-protobuf.
+package keycloakext
+
+// Generated with protoc 3.12.4, protoc-gen-go v1.31.0 and protoc-gen-go-grpc 1.2.0.
+// keycloak/keycloak.proto is resolved from the copy in proto/keycloak and mapped
+// to the upstream Go package instead of being generated.
+
+//go:generate sh -c "protoc -I ../../proto --go_out=../.. --go_opt=module=github.com/hub1989/keycloak-grpc-service,Mkeycloak/keycloak.proto=github.com/hub1989/keycloak-protobuf/golang/keycloak --go-grpc_out=../.. --go-grpc_opt=module=github.com/hub1989/keycloak-grpc-service,Mkeycloak/keycloak.proto=github.com/hub1989/keycloak-protobuf/golang/keycloak keycloak/ext/*.proto"
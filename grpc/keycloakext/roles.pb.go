@@ -0,0 +1,2093 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.12.4
+// source: keycloak/ext/roles.proto
+
+package keycloakext
+
+import (
+	keycloak "github.com/hub1989/keycloak-protobuf/golang/keycloak"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RoleMappingView int32
+
+const (
+	// the roles mapped directly
+	RoleMappingView_ASSIGNED RoleMappingView = 0
+	// the roles that can still be mapped
+	RoleMappingView_AVAILABLE RoleMappingView = 1
+	// the mapped roles including those inherited through composite roles and groups
+	RoleMappingView_EFFECTIVE RoleMappingView = 2
+)
+
+// Enum value maps for RoleMappingView.
+var (
+	RoleMappingView_name = map[int32]string{
+		0: "ASSIGNED",
+		1: "AVAILABLE",
+		2: "EFFECTIVE",
+	}
+	RoleMappingView_value = map[string]int32{
+		"ASSIGNED":  0,
+		"AVAILABLE": 1,
+		"EFFECTIVE": 2,
+	}
+)
+
+func (x RoleMappingView) Enum() *RoleMappingView {
+	p := new(RoleMappingView)
+	*p = x
+	return p
+}
+
+func (x RoleMappingView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoleMappingView) Descriptor() protoreflect.EnumDescriptor {
+	return file_keycloak_ext_roles_proto_enumTypes[0].Descriptor()
+}
+
+func (RoleMappingView) Type() protoreflect.EnumType {
+	return &file_keycloak_ext_roles_proto_enumTypes[0]
+}
+
+func (x RoleMappingView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoleMappingView.Descriptor instead.
+func (RoleMappingView) EnumDescriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{0}
+}
+
+type Role struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Composite   bool   `protobuf:"varint,4,opt,name=composite,proto3" json:"composite,omitempty"`
+	ClientRole  bool   `protobuf:"varint,5,opt,name=clientRole,proto3" json:"clientRole,omitempty"`
+	// the realm or client id the role belongs to
+	ContainerId string                      `protobuf:"bytes,6,opt,name=containerId,proto3" json:"containerId,omitempty"`
+	Attributes  map[string]*AttributeValues `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Role) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Role) GetComposite() bool {
+	if x != nil {
+		return x.Composite
+	}
+	return false
+}
+
+func (x *Role) GetClientRole() bool {
+	if x != nil {
+		return x.ClientRole
+	}
+	return false
+}
+
+func (x *Role) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *Role) GetAttributes() map[string]*AttributeValues {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type ListRolesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// matches the role name by substring
+	Search *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	First  int32                   `protobuf:"varint,2,opt,name=first,proto3" json:"first,omitempty"`
+	Max    int32                   `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
+	// nextPageToken of a previous response, takes precedence over first
+	PageToken string `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	// leave out attributes, which keycloak does not return for brief representations
+	Brief bool `protobuf:"varint,5,opt,name=brief,proto3" json:"brief,omitempty"`
+}
+
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesRequest) ProtoMessage() {}
+
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRolesRequest) GetSearch() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *ListRolesRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *ListRolesRequest) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ListRolesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRolesRequest) GetBrief() bool {
+	if x != nil {
+		return x.Brief
+	}
+	return false
+}
+
+type RolesPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles []*Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	// empty when there are no more results
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *RolesPage) Reset() {
+	*x = RolesPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolesPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolesPage) ProtoMessage() {}
+
+func (x *RolesPage) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolesPage.ProtoReflect.Descriptor instead.
+func (*RolesPage) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RolesPage) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *RolesPage) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the name of the role to update
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// left unchanged when not set
+	Description *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// merged into the existing attributes; an attribute without values is removed
+	Attributes map[string]*AttributeValues `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetAttributes() map[string]*AttributeValues {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type RoleMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the name of the role
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	First int32  `protobuf:"varint,2,opt,name=first,proto3" json:"first,omitempty"`
+	Max   int32  `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
+	// nextPageToken of a previous response, takes precedence over first
+	PageToken string `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *RoleMembersRequest) Reset() {
+	*x = RoleMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleMembersRequest) ProtoMessage() {}
+
+func (x *RoleMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleMembersRequest.ProtoReflect.Descriptor instead.
+func (*RoleMembersRequest) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RoleMembersRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleMembersRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *RoleMembersRequest) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *RoleMembersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type RoleGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*keycloak.GroupResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// empty when there are no more results
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *RoleGroupsResponse) Reset() {
+	*x = RoleGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleGroupsResponse) ProtoMessage() {}
+
+func (x *RoleGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleGroupsResponse.ProtoReflect.Descriptor instead.
+func (*RoleGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RoleGroupsResponse) GetGroups() []*keycloak.GroupResponse {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *RoleGroupsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// identifies a client either by its internal id or by its clientId
+type ClientRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Client:
+	//	*ClientRef_Id
+	//	*ClientRef_ClientId
+	Client isClientRef_Client `protobuf_oneof:"client"`
+}
+
+func (x *ClientRef) Reset() {
+	*x = ClientRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientRef) ProtoMessage() {}
+
+func (x *ClientRef) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientRef.ProtoReflect.Descriptor instead.
+func (*ClientRef) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *ClientRef) GetClient() isClientRef_Client {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+func (x *ClientRef) GetId() string {
+	if x, ok := x.GetClient().(*ClientRef_Id); ok {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClientRef) GetClientId() string {
+	if x, ok := x.GetClient().(*ClientRef_ClientId); ok {
+		return x.ClientId
+	}
+	return ""
+}
+
+type isClientRef_Client interface {
+	isClientRef_Client()
+}
+
+type ClientRef_Id struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+type ClientRef_ClientId struct {
+	ClientId string `protobuf:"bytes,2,opt,name=clientId,proto3,oneof"`
+}
+
+func (*ClientRef_Id) isClientRef_Client() {}
+
+func (*ClientRef_ClientId) isClientRef_Client() {}
+
+type ListClientRolesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Client *ClientRef `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	// matches the role name by substring
+	Search *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`
+	First  int32                   `protobuf:"varint,3,opt,name=first,proto3" json:"first,omitempty"`
+	Max    int32                   `protobuf:"varint,4,opt,name=max,proto3" json:"max,omitempty"`
+	// nextPageToken of a previous response, takes precedence over first
+	PageToken string `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	// leave out attributes, which keycloak does not return for brief representations
+	Brief bool `protobuf:"varint,6,opt,name=brief,proto3" json:"brief,omitempty"`
+}
+
+func (x *ListClientRolesRequest) Reset() {
+	*x = ListClientRolesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClientRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientRolesRequest) ProtoMessage() {}
+
+func (x *ListClientRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListClientRolesRequest) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListClientRolesRequest) GetClient() *ClientRef {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *ListClientRolesRequest) GetSearch() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *ListClientRolesRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *ListClientRolesRequest) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ListClientRolesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListClientRolesRequest) GetBrief() bool {
+	if x != nil {
+		return x.Brief
+	}
+	return false
+}
+
+type ClientRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Client *ClientRef `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	// the name of the role
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ClientRoleRequest) Reset() {
+	*x = ClientRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientRoleRequest) ProtoMessage() {}
+
+func (x *ClientRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientRoleRequest.ProtoReflect.Descriptor instead.
+func (*ClientRoleRequest) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ClientRoleRequest) GetClient() *ClientRef {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *ClientRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateClientRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Client *ClientRef `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Role   *Role      `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *CreateClientRoleRequest) Reset() {
+	*x = CreateClientRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateClientRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClientRoleRequest) ProtoMessage() {}
+
+func (x *CreateClientRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClientRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateClientRoleRequest) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateClientRoleRequest) GetClient() *ClientRef {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *CreateClientRoleRequest) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type UpdateClientRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Client *ClientRef         `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Role   *UpdateRoleRequest `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *UpdateClientRoleRequest) Reset() {
+	*x = UpdateClientRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateClientRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClientRoleRequest) ProtoMessage() {}
+
+func (x *UpdateClientRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClientRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClientRoleRequest) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateClientRoleRequest) GetClient() *ClientRef {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *UpdateClientRoleRequest) GetRole() *UpdateRoleRequest {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type ClientRoleMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Client *ClientRef `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	// the name of the role
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	First int32  `protobuf:"varint,3,opt,name=first,proto3" json:"first,omitempty"`
+	Max   int32  `protobuf:"varint,4,opt,name=max,proto3" json:"max,omitempty"`
+	// nextPageToken of a previous response, takes precedence over first
+	PageToken string `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *ClientRoleMembersRequest) Reset() {
+	*x = ClientRoleMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientRoleMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientRoleMembersRequest) ProtoMessage() {}
+
+func (x *ClientRoleMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientRoleMembersRequest.ProtoReflect.Descriptor instead.
+func (*ClientRoleMembersRequest) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ClientRoleMembersRequest) GetClient() *ClientRef {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *ClientRoleMembersRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClientRoleMembersRequest) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *ClientRoleMembersRequest) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ClientRoleMembersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ClientRoleMappingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Holder:
+	//	*ClientRoleMappingRequest_UserId
+	//	*ClientRoleMappingRequest_GroupId
+	Holder isClientRoleMappingRequest_Holder `protobuf_oneof:"holder"`
+	Client *ClientRef                        `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	// the names of the client roles
+	Roles []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *ClientRoleMappingRequest) Reset() {
+	*x = ClientRoleMappingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientRoleMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientRoleMappingRequest) ProtoMessage() {}
+
+func (x *ClientRoleMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientRoleMappingRequest.ProtoReflect.Descriptor instead.
+func (*ClientRoleMappingRequest) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{12}
+}
+
+func (m *ClientRoleMappingRequest) GetHolder() isClientRoleMappingRequest_Holder {
+	if m != nil {
+		return m.Holder
+	}
+	return nil
+}
+
+func (x *ClientRoleMappingRequest) GetUserId() string {
+	if x, ok := x.GetHolder().(*ClientRoleMappingRequest_UserId); ok {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClientRoleMappingRequest) GetGroupId() string {
+	if x, ok := x.GetHolder().(*ClientRoleMappingRequest_GroupId); ok {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ClientRoleMappingRequest) GetClient() *ClientRef {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *ClientRoleMappingRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type isClientRoleMappingRequest_Holder interface {
+	isClientRoleMappingRequest_Holder()
+}
+
+type ClientRoleMappingRequest_UserId struct {
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3,oneof"`
+}
+
+type ClientRoleMappingRequest_GroupId struct {
+	GroupId string `protobuf:"bytes,2,opt,name=groupId,proto3,oneof"`
+}
+
+func (*ClientRoleMappingRequest_UserId) isClientRoleMappingRequest_Holder() {}
+
+func (*ClientRoleMappingRequest_GroupId) isClientRoleMappingRequest_Holder() {}
+
+// identifies a realm role, or a client role when client is set
+type RoleRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Client *ClientRef `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+}
+
+func (x *RoleRef) Reset() {
+	*x = RoleRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleRef) ProtoMessage() {}
+
+func (x *RoleRef) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleRef.ProtoReflect.Descriptor instead.
+func (*RoleRef) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RoleRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleRef) GetClient() *ClientRef {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+type CompositeRolesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the composite role
+	Role *RoleRef `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// the child roles to add or remove
+	Composites []*RoleRef `protobuf:"bytes,2,rep,name=composites,proto3" json:"composites,omitempty"`
+}
+
+func (x *CompositeRolesRequest) Reset() {
+	*x = CompositeRolesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompositeRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompositeRolesRequest) ProtoMessage() {}
+
+func (x *CompositeRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompositeRolesRequest.ProtoReflect.Descriptor instead.
+func (*CompositeRolesRequest) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CompositeRolesRequest) GetRole() *RoleRef {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *CompositeRolesRequest) GetComposites() []*RoleRef {
+	if x != nil {
+		return x.Composites
+	}
+	return nil
+}
+
+type EffectiveRolesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// limits the client roles to these clients, all clients of the realm when empty
+	Clients []*ClientRef `protobuf:"bytes,2,rep,name=clients,proto3" json:"clients,omitempty"`
+}
+
+func (x *EffectiveRolesRequest) Reset() {
+	*x = EffectiveRolesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EffectiveRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectiveRolesRequest) ProtoMessage() {}
+
+func (x *EffectiveRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectiveRolesRequest.ProtoReflect.Descriptor instead.
+func (*EffectiveRolesRequest) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EffectiveRolesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EffectiveRolesRequest) GetClients() []*ClientRef {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+type EffectiveRolesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RealmRoles []*Role `protobuf:"bytes,1,rep,name=realmRoles,proto3" json:"realmRoles,omitempty"`
+	// keyed by clientId, clients the user holds no roles of are left out
+	ClientRoles map[string]*RolesResponse `protobuf:"bytes,2,rep,name=clientRoles,proto3" json:"clientRoles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *EffectiveRolesResponse) Reset() {
+	*x = EffectiveRolesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EffectiveRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectiveRolesResponse) ProtoMessage() {}
+
+func (x *EffectiveRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectiveRolesResponse.ProtoReflect.Descriptor instead.
+func (*EffectiveRolesResponse) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EffectiveRolesResponse) GetRealmRoles() []*Role {
+	if x != nil {
+		return x.RealmRoles
+	}
+	return nil
+}
+
+func (x *EffectiveRolesResponse) GetClientRoles() map[string]*RolesResponse {
+	if x != nil {
+		return x.ClientRoles
+	}
+	return nil
+}
+
+type ListClientRoleMappingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Holder:
+	//	*ListClientRoleMappingsRequest_UserId
+	//	*ListClientRoleMappingsRequest_GroupId
+	Holder isListClientRoleMappingsRequest_Holder `protobuf_oneof:"holder"`
+	Client *ClientRef                             `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	View   RoleMappingView                        `protobuf:"varint,4,opt,name=view,proto3,enum=keycloak.ext.RoleMappingView" json:"view,omitempty"`
+}
+
+func (x *ListClientRoleMappingsRequest) Reset() {
+	*x = ListClientRoleMappingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClientRoleMappingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientRoleMappingsRequest) ProtoMessage() {}
+
+func (x *ListClientRoleMappingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientRoleMappingsRequest.ProtoReflect.Descriptor instead.
+func (*ListClientRoleMappingsRequest) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *ListClientRoleMappingsRequest) GetHolder() isListClientRoleMappingsRequest_Holder {
+	if m != nil {
+		return m.Holder
+	}
+	return nil
+}
+
+func (x *ListClientRoleMappingsRequest) GetUserId() string {
+	if x, ok := x.GetHolder().(*ListClientRoleMappingsRequest_UserId); ok {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListClientRoleMappingsRequest) GetGroupId() string {
+	if x, ok := x.GetHolder().(*ListClientRoleMappingsRequest_GroupId); ok {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ListClientRoleMappingsRequest) GetClient() *ClientRef {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *ListClientRoleMappingsRequest) GetView() RoleMappingView {
+	if x != nil {
+		return x.View
+	}
+	return RoleMappingView_ASSIGNED
+}
+
+type isListClientRoleMappingsRequest_Holder interface {
+	isListClientRoleMappingsRequest_Holder()
+}
+
+type ListClientRoleMappingsRequest_UserId struct {
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3,oneof"`
+}
+
+type ListClientRoleMappingsRequest_GroupId struct {
+	GroupId string `protobuf:"bytes,2,opt,name=groupId,proto3,oneof"`
+}
+
+func (*ListClientRoleMappingsRequest_UserId) isListClientRoleMappingsRequest_Holder() {}
+
+func (*ListClientRoleMappingsRequest_GroupId) isListClientRoleMappingsRequest_Holder() {}
+
+type RolesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles []*Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *RolesResponse) Reset() {
+	*x = RolesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keycloak_ext_roles_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolesResponse) ProtoMessage() {}
+
+func (x *RolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keycloak_ext_roles_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolesResponse.ProtoReflect.Descriptor instead.
+func (*RolesResponse) Descriptor() ([]byte, []int) {
+	return file_keycloak_ext_roles_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+var File_keycloak_ext_roles_proto protoreflect.FileDescriptor
+
+var file_keycloak_ext_roles_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2f, 0x65, 0x78, 0x74, 0x2f, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6b, 0x65, 0x79, 0x63,
+	0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2f,
+	0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d,
+	0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2f, 0x65, 0x78, 0x74, 0x2f, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6b,
+	0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2f, 0x65, 0x78, 0x74, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x02, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6b, 0x65, 0x79,
+	0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x5c, 0x0a, 0x0f, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa4, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x69,
+	0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x72, 0x69, 0x65, 0x66, 0x22,
+	0x5b, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b, 0x65,
+	0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x96, 0x02, 0x0a,
+	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x65, 0x79,
+	0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x5c, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6b, 0x65,
+	0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6e, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6b, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x65,
+	0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x45, 0x0a, 0x09, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x12,
+	0x10, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1c, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x42,
+	0x08, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xdb, 0x01, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e,
+	0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6d, 0x61, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x69, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x62, 0x72, 0x69, 0x65, 0x66, 0x22, 0x58, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b,
+	0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x72, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b,
+	0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b, 0x65,
+	0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x7f, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x33, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x18, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65,
+	0x78, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa1,
+	0x01, 0x0a, 0x18, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x22, 0x4e, 0x0a, 0x07, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x22, 0x79, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6b, 0x65, 0x79, 0x63,
+	0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x66,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6b, 0x65, 0x79,
+	0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x66, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x73, 0x22, 0x62, 0x0a,
+	0x15, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31,
+	0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x82, 0x02, 0x0a, 0x16, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x12, 0x57, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b,
+	0x2e, 0x65, 0x78, 0x74, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x5b, 0x0a, 0x10, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc3, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2f,
+	0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x31, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x42, 0x08, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x22, 0x39, 0x0a, 0x0d,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b,
+	0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2a, 0x3d, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x56, 0x69, 0x65, 0x77, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x53,
+	0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x56, 0x41, 0x49,
+	0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x46, 0x46, 0x45, 0x43,
+	0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x32, 0xcb, 0x0d, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x65, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x2e,
+	0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e,
+	0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x6b, 0x65,
+	0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x1a,
+	0x12, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61,
+	0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f,
+	0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x59, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x6c,
+	0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x6b, 0x65, 0x79,
+	0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b,
+	0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x6f, 0x6c, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x20, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61,
+	0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c,
+	0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x2e,
+	0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65,
+	0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x2e,
+	0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61,
+	0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x12, 0x4d, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b,
+	0x2e, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b,
+	0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x4b, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e,
+	0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x60, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x26, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e,
+	0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6b,
+	0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x60, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x26, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f,
+	0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52,
+	0x6f, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x53, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61,
+	0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x53, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x6b, 0x65,
+	0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x62, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2b, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b,
+	0x2e, 0x65, 0x78, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78,
+	0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x50, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e,
+	0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x53, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x6b, 0x65, 0x79, 0x63,
+	0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x6b,
+	0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x66, 0x1a, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65,
+	0x78, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x62, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x6b, 0x65, 0x79, 0x63,
+	0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x45, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x68, 0x75, 0x62, 0x31, 0x39, 0x38, 0x39, 0x2f, 0x6b, 0x65, 0x79, 0x63, 0x6c,
+	0x6f, 0x61, 0x6b, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x6b, 0x65, 0x79, 0x63, 0x6c, 0x6f, 0x61, 0x6b, 0x65, 0x78,
+	0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_keycloak_ext_roles_proto_rawDescOnce sync.Once
+	file_keycloak_ext_roles_proto_rawDescData = file_keycloak_ext_roles_proto_rawDesc
+)
+
+func file_keycloak_ext_roles_proto_rawDescGZIP() []byte {
+	file_keycloak_ext_roles_proto_rawDescOnce.Do(func() {
+		file_keycloak_ext_roles_proto_rawDescData = protoimpl.X.CompressGZIP(file_keycloak_ext_roles_proto_rawDescData)
+	})
+	return file_keycloak_ext_roles_proto_rawDescData
+}
+
+var file_keycloak_ext_roles_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_keycloak_ext_roles_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_keycloak_ext_roles_proto_goTypes = []interface{}{
+	(RoleMappingView)(0),                  // 0: keycloak.ext.RoleMappingView
+	(*Role)(nil),                          // 1: keycloak.ext.Role
+	(*ListRolesRequest)(nil),              // 2: keycloak.ext.ListRolesRequest
+	(*RolesPage)(nil),                     // 3: keycloak.ext.RolesPage
+	(*UpdateRoleRequest)(nil),             // 4: keycloak.ext.UpdateRoleRequest
+	(*RoleMembersRequest)(nil),            // 5: keycloak.ext.RoleMembersRequest
+	(*RoleGroupsResponse)(nil),            // 6: keycloak.ext.RoleGroupsResponse
+	(*ClientRef)(nil),                     // 7: keycloak.ext.ClientRef
+	(*ListClientRolesRequest)(nil),        // 8: keycloak.ext.ListClientRolesRequest
+	(*ClientRoleRequest)(nil),             // 9: keycloak.ext.ClientRoleRequest
+	(*CreateClientRoleRequest)(nil),       // 10: keycloak.ext.CreateClientRoleRequest
+	(*UpdateClientRoleRequest)(nil),       // 11: keycloak.ext.UpdateClientRoleRequest
+	(*ClientRoleMembersRequest)(nil),      // 12: keycloak.ext.ClientRoleMembersRequest
+	(*ClientRoleMappingRequest)(nil),      // 13: keycloak.ext.ClientRoleMappingRequest
+	(*RoleRef)(nil),                       // 14: keycloak.ext.RoleRef
+	(*CompositeRolesRequest)(nil),         // 15: keycloak.ext.CompositeRolesRequest
+	(*EffectiveRolesRequest)(nil),         // 16: keycloak.ext.EffectiveRolesRequest
+	(*EffectiveRolesResponse)(nil),        // 17: keycloak.ext.EffectiveRolesResponse
+	(*ListClientRoleMappingsRequest)(nil), // 18: keycloak.ext.ListClientRoleMappingsRequest
+	(*RolesResponse)(nil),                 // 19: keycloak.ext.RolesResponse
+	nil,                                   // 20: keycloak.ext.Role.AttributesEntry
+	nil,                                   // 21: keycloak.ext.UpdateRoleRequest.AttributesEntry
+	nil,                                   // 22: keycloak.ext.EffectiveRolesResponse.ClientRolesEntry
+	(*wrapperspb.StringValue)(nil),        // 23: google.protobuf.StringValue
+	(*keycloak.GroupResponse)(nil),        // 24: keycloak.GroupResponse
+	(*AttributeValues)(nil),               // 25: keycloak.ext.AttributeValues
+	(*emptypb.Empty)(nil),                 // 26: google.protobuf.Empty
+	(*SearchUsersResponse)(nil),           // 27: keycloak.ext.SearchUsersResponse
+}
+var file_keycloak_ext_roles_proto_depIdxs = []int32{
+	20, // 0: keycloak.ext.Role.attributes:type_name -> keycloak.ext.Role.AttributesEntry
+	23, // 1: keycloak.ext.ListRolesRequest.search:type_name -> google.protobuf.StringValue
+	1,  // 2: keycloak.ext.RolesPage.roles:type_name -> keycloak.ext.Role
+	23, // 3: keycloak.ext.UpdateRoleRequest.description:type_name -> google.protobuf.StringValue
+	21, // 4: keycloak.ext.UpdateRoleRequest.attributes:type_name -> keycloak.ext.UpdateRoleRequest.AttributesEntry
+	24, // 5: keycloak.ext.RoleGroupsResponse.groups:type_name -> keycloak.GroupResponse
+	7,  // 6: keycloak.ext.ListClientRolesRequest.client:type_name -> keycloak.ext.ClientRef
+	23, // 7: keycloak.ext.ListClientRolesRequest.search:type_name -> google.protobuf.StringValue
+	7,  // 8: keycloak.ext.ClientRoleRequest.client:type_name -> keycloak.ext.ClientRef
+	7,  // 9: keycloak.ext.CreateClientRoleRequest.client:type_name -> keycloak.ext.ClientRef
+	1,  // 10: keycloak.ext.CreateClientRoleRequest.role:type_name -> keycloak.ext.Role
+	7,  // 11: keycloak.ext.UpdateClientRoleRequest.client:type_name -> keycloak.ext.ClientRef
+	4,  // 12: keycloak.ext.UpdateClientRoleRequest.role:type_name -> keycloak.ext.UpdateRoleRequest
+	7,  // 13: keycloak.ext.ClientRoleMembersRequest.client:type_name -> keycloak.ext.ClientRef
+	7,  // 14: keycloak.ext.ClientRoleMappingRequest.client:type_name -> keycloak.ext.ClientRef
+	7,  // 15: keycloak.ext.RoleRef.client:type_name -> keycloak.ext.ClientRef
+	14, // 16: keycloak.ext.CompositeRolesRequest.role:type_name -> keycloak.ext.RoleRef
+	14, // 17: keycloak.ext.CompositeRolesRequest.composites:type_name -> keycloak.ext.RoleRef
+	7,  // 18: keycloak.ext.EffectiveRolesRequest.clients:type_name -> keycloak.ext.ClientRef
+	1,  // 19: keycloak.ext.EffectiveRolesResponse.realmRoles:type_name -> keycloak.ext.Role
+	22, // 20: keycloak.ext.EffectiveRolesResponse.clientRoles:type_name -> keycloak.ext.EffectiveRolesResponse.ClientRolesEntry
+	7,  // 21: keycloak.ext.ListClientRoleMappingsRequest.client:type_name -> keycloak.ext.ClientRef
+	0,  // 22: keycloak.ext.ListClientRoleMappingsRequest.view:type_name -> keycloak.ext.RoleMappingView
+	1,  // 23: keycloak.ext.RolesResponse.roles:type_name -> keycloak.ext.Role
+	25, // 24: keycloak.ext.Role.AttributesEntry.value:type_name -> keycloak.ext.AttributeValues
+	25, // 25: keycloak.ext.UpdateRoleRequest.AttributesEntry.value:type_name -> keycloak.ext.AttributeValues
+	19, // 26: keycloak.ext.EffectiveRolesResponse.ClientRolesEntry.value:type_name -> keycloak.ext.RolesResponse
+	2,  // 27: keycloak.ext.RoleAdminService.ListRealmRoles:input_type -> keycloak.ext.ListRolesRequest
+	23, // 28: keycloak.ext.RoleAdminService.GetRealmRole:input_type -> google.protobuf.StringValue
+	1,  // 29: keycloak.ext.RoleAdminService.CreateRealmRole:input_type -> keycloak.ext.Role
+	4,  // 30: keycloak.ext.RoleAdminService.UpdateRealmRole:input_type -> keycloak.ext.UpdateRoleRequest
+	23, // 31: keycloak.ext.RoleAdminService.DeleteRealmRole:input_type -> google.protobuf.StringValue
+	5,  // 32: keycloak.ext.RoleAdminService.ListRealmRoleUsers:input_type -> keycloak.ext.RoleMembersRequest
+	5,  // 33: keycloak.ext.RoleAdminService.ListRealmRoleGroups:input_type -> keycloak.ext.RoleMembersRequest
+	8,  // 34: keycloak.ext.RoleAdminService.ListClientRoles:input_type -> keycloak.ext.ListClientRolesRequest
+	9,  // 35: keycloak.ext.RoleAdminService.GetClientRole:input_type -> keycloak.ext.ClientRoleRequest
+	10, // 36: keycloak.ext.RoleAdminService.CreateClientRole:input_type -> keycloak.ext.CreateClientRoleRequest
+	11, // 37: keycloak.ext.RoleAdminService.UpdateClientRole:input_type -> keycloak.ext.UpdateClientRoleRequest
+	9,  // 38: keycloak.ext.RoleAdminService.DeleteClientRole:input_type -> keycloak.ext.ClientRoleRequest
+	12, // 39: keycloak.ext.RoleAdminService.ListClientRoleUsers:input_type -> keycloak.ext.ClientRoleMembersRequest
+	12, // 40: keycloak.ext.RoleAdminService.ListClientRoleGroups:input_type -> keycloak.ext.ClientRoleMembersRequest
+	13, // 41: keycloak.ext.RoleAdminService.AssignClientRoles:input_type -> keycloak.ext.ClientRoleMappingRequest
+	13, // 42: keycloak.ext.RoleAdminService.RemoveClientRoles:input_type -> keycloak.ext.ClientRoleMappingRequest
+	18, // 43: keycloak.ext.RoleAdminService.ListClientRoleMappings:input_type -> keycloak.ext.ListClientRoleMappingsRequest
+	15, // 44: keycloak.ext.RoleAdminService.AddCompositeRoles:input_type -> keycloak.ext.CompositeRolesRequest
+	15, // 45: keycloak.ext.RoleAdminService.RemoveCompositeRoles:input_type -> keycloak.ext.CompositeRolesRequest
+	14, // 46: keycloak.ext.RoleAdminService.ListCompositeRoles:input_type -> keycloak.ext.RoleRef
+	16, // 47: keycloak.ext.RoleAdminService.GetEffectiveUserRoles:input_type -> keycloak.ext.EffectiveRolesRequest
+	3,  // 48: keycloak.ext.RoleAdminService.ListRealmRoles:output_type -> keycloak.ext.RolesPage
+	1,  // 49: keycloak.ext.RoleAdminService.GetRealmRole:output_type -> keycloak.ext.Role
+	1,  // 50: keycloak.ext.RoleAdminService.CreateRealmRole:output_type -> keycloak.ext.Role
+	1,  // 51: keycloak.ext.RoleAdminService.UpdateRealmRole:output_type -> keycloak.ext.Role
+	26, // 52: keycloak.ext.RoleAdminService.DeleteRealmRole:output_type -> google.protobuf.Empty
+	27, // 53: keycloak.ext.RoleAdminService.ListRealmRoleUsers:output_type -> keycloak.ext.SearchUsersResponse
+	6,  // 54: keycloak.ext.RoleAdminService.ListRealmRoleGroups:output_type -> keycloak.ext.RoleGroupsResponse
+	3,  // 55: keycloak.ext.RoleAdminService.ListClientRoles:output_type -> keycloak.ext.RolesPage
+	1,  // 56: keycloak.ext.RoleAdminService.GetClientRole:output_type -> keycloak.ext.Role
+	1,  // 57: keycloak.ext.RoleAdminService.CreateClientRole:output_type -> keycloak.ext.Role
+	1,  // 58: keycloak.ext.RoleAdminService.UpdateClientRole:output_type -> keycloak.ext.Role
+	26, // 59: keycloak.ext.RoleAdminService.DeleteClientRole:output_type -> google.protobuf.Empty
+	27, // 60: keycloak.ext.RoleAdminService.ListClientRoleUsers:output_type -> keycloak.ext.SearchUsersResponse
+	6,  // 61: keycloak.ext.RoleAdminService.ListClientRoleGroups:output_type -> keycloak.ext.RoleGroupsResponse
+	26, // 62: keycloak.ext.RoleAdminService.AssignClientRoles:output_type -> google.protobuf.Empty
+	26, // 63: keycloak.ext.RoleAdminService.RemoveClientRoles:output_type -> google.protobuf.Empty
+	19, // 64: keycloak.ext.RoleAdminService.ListClientRoleMappings:output_type -> keycloak.ext.RolesResponse
+	26, // 65: keycloak.ext.RoleAdminService.AddCompositeRoles:output_type -> google.protobuf.Empty
+	26, // 66: keycloak.ext.RoleAdminService.RemoveCompositeRoles:output_type -> google.protobuf.Empty
+	19, // 67: keycloak.ext.RoleAdminService.ListCompositeRoles:output_type -> keycloak.ext.RolesResponse
+	17, // 68: keycloak.ext.RoleAdminService.GetEffectiveUserRoles:output_type -> keycloak.ext.EffectiveRolesResponse
+	48, // [48:69] is the sub-list for method output_type
+	27, // [27:48] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
+}
+
+func init() { file_keycloak_ext_roles_proto_init() }
+func file_keycloak_ext_roles_proto_init() {
+	if File_keycloak_ext_roles_proto != nil {
+		return
+	}
+	file_keycloak_ext_attributes_proto_init()
+	file_keycloak_ext_users_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_keycloak_ext_roles_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Role); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRolesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolesPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClientRolesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateClientRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateClientRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientRoleMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientRoleMappingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompositeRolesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EffectiveRolesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EffectiveRolesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClientRoleMappingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keycloak_ext_roles_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_keycloak_ext_roles_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*ClientRef_Id)(nil),
+		(*ClientRef_ClientId)(nil),
+	}
+	file_keycloak_ext_roles_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*ClientRoleMappingRequest_UserId)(nil),
+		(*ClientRoleMappingRequest_GroupId)(nil),
+	}
+	file_keycloak_ext_roles_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*ListClientRoleMappingsRequest_UserId)(nil),
+		(*ListClientRoleMappingsRequest_GroupId)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_keycloak_ext_roles_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_keycloak_ext_roles_proto_goTypes,
+		DependencyIndexes: file_keycloak_ext_roles_proto_depIdxs,
+		EnumInfos:         file_keycloak_ext_roles_proto_enumTypes,
+		MessageInfos:      file_keycloak_ext_roles_proto_msgTypes,
+	}.Build()
+	File_keycloak_ext_roles_proto = out.File
+	file_keycloak_ext_roles_proto_rawDesc = nil
+	file_keycloak_ext_roles_proto_goTypes = nil
+	file_keycloak_ext_roles_proto_depIdxs = nil
+}
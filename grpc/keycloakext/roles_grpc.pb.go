@@ -0,0 +1,837 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: keycloak/ext/roles.proto
+
+package keycloakext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RoleAdminServiceClient is the client API for RoleAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RoleAdminServiceClient interface {
+	ListRealmRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*RolesPage, error)
+	// takes the role name
+	GetRealmRole(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Role, error)
+	CreateRealmRole(ctx context.Context, in *Role, opts ...grpc.CallOption) (*Role, error)
+	UpdateRealmRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*Role, error)
+	// takes the role name
+	DeleteRealmRole(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListRealmRoleUsers(ctx context.Context, in *RoleMembersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	ListRealmRoleGroups(ctx context.Context, in *RoleMembersRequest, opts ...grpc.CallOption) (*RoleGroupsResponse, error)
+	ListClientRoles(ctx context.Context, in *ListClientRolesRequest, opts ...grpc.CallOption) (*RolesPage, error)
+	GetClientRole(ctx context.Context, in *ClientRoleRequest, opts ...grpc.CallOption) (*Role, error)
+	CreateClientRole(ctx context.Context, in *CreateClientRoleRequest, opts ...grpc.CallOption) (*Role, error)
+	UpdateClientRole(ctx context.Context, in *UpdateClientRoleRequest, opts ...grpc.CallOption) (*Role, error)
+	DeleteClientRole(ctx context.Context, in *ClientRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListClientRoleUsers(ctx context.Context, in *ClientRoleMembersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	ListClientRoleGroups(ctx context.Context, in *ClientRoleMembersRequest, opts ...grpc.CallOption) (*RoleGroupsResponse, error)
+	// maps client roles to a user or group
+	AssignClientRoles(ctx context.Context, in *ClientRoleMappingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveClientRoles(ctx context.Context, in *ClientRoleMappingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListClientRoleMappings(ctx context.Context, in *ListClientRoleMappingsRequest, opts ...grpc.CallOption) (*RolesResponse, error)
+	AddCompositeRoles(ctx context.Context, in *CompositeRolesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveCompositeRoles(ctx context.Context, in *CompositeRolesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// lists the direct children of a composite role
+	ListCompositeRoles(ctx context.Context, in *RoleRef, opts ...grpc.CallOption) (*RolesResponse, error)
+	// the roles a user holds directly, through composite roles and through groups
+	GetEffectiveUserRoles(ctx context.Context, in *EffectiveRolesRequest, opts ...grpc.CallOption) (*EffectiveRolesResponse, error)
+}
+
+type roleAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoleAdminServiceClient(cc grpc.ClientConnInterface) RoleAdminServiceClient {
+	return &roleAdminServiceClient{cc}
+}
+
+func (c *roleAdminServiceClient) ListRealmRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*RolesPage, error) {
+	out := new(RolesPage)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/ListRealmRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) GetRealmRole(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Role, error) {
+	out := new(Role)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/GetRealmRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) CreateRealmRole(ctx context.Context, in *Role, opts ...grpc.CallOption) (*Role, error) {
+	out := new(Role)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/CreateRealmRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) UpdateRealmRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*Role, error) {
+	out := new(Role)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/UpdateRealmRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) DeleteRealmRole(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/DeleteRealmRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) ListRealmRoleUsers(ctx context.Context, in *RoleMembersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/ListRealmRoleUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) ListRealmRoleGroups(ctx context.Context, in *RoleMembersRequest, opts ...grpc.CallOption) (*RoleGroupsResponse, error) {
+	out := new(RoleGroupsResponse)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/ListRealmRoleGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) ListClientRoles(ctx context.Context, in *ListClientRolesRequest, opts ...grpc.CallOption) (*RolesPage, error) {
+	out := new(RolesPage)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/ListClientRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) GetClientRole(ctx context.Context, in *ClientRoleRequest, opts ...grpc.CallOption) (*Role, error) {
+	out := new(Role)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/GetClientRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) CreateClientRole(ctx context.Context, in *CreateClientRoleRequest, opts ...grpc.CallOption) (*Role, error) {
+	out := new(Role)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/CreateClientRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) UpdateClientRole(ctx context.Context, in *UpdateClientRoleRequest, opts ...grpc.CallOption) (*Role, error) {
+	out := new(Role)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/UpdateClientRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) DeleteClientRole(ctx context.Context, in *ClientRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/DeleteClientRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) ListClientRoleUsers(ctx context.Context, in *ClientRoleMembersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/ListClientRoleUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) ListClientRoleGroups(ctx context.Context, in *ClientRoleMembersRequest, opts ...grpc.CallOption) (*RoleGroupsResponse, error) {
+	out := new(RoleGroupsResponse)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/ListClientRoleGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) AssignClientRoles(ctx context.Context, in *ClientRoleMappingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/AssignClientRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) RemoveClientRoles(ctx context.Context, in *ClientRoleMappingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/RemoveClientRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) ListClientRoleMappings(ctx context.Context, in *ListClientRoleMappingsRequest, opts ...grpc.CallOption) (*RolesResponse, error) {
+	out := new(RolesResponse)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/ListClientRoleMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) AddCompositeRoles(ctx context.Context, in *CompositeRolesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/AddCompositeRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) RemoveCompositeRoles(ctx context.Context, in *CompositeRolesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/RemoveCompositeRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) ListCompositeRoles(ctx context.Context, in *RoleRef, opts ...grpc.CallOption) (*RolesResponse, error) {
+	out := new(RolesResponse)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/ListCompositeRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleAdminServiceClient) GetEffectiveUserRoles(ctx context.Context, in *EffectiveRolesRequest, opts ...grpc.CallOption) (*EffectiveRolesResponse, error) {
+	out := new(EffectiveRolesResponse)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.RoleAdminService/GetEffectiveUserRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoleAdminServiceServer is the server API for RoleAdminService service.
+// All implementations must embed UnimplementedRoleAdminServiceServer
+// for forward compatibility
+type RoleAdminServiceServer interface {
+	ListRealmRoles(context.Context, *ListRolesRequest) (*RolesPage, error)
+	// takes the role name
+	GetRealmRole(context.Context, *wrapperspb.StringValue) (*Role, error)
+	CreateRealmRole(context.Context, *Role) (*Role, error)
+	UpdateRealmRole(context.Context, *UpdateRoleRequest) (*Role, error)
+	// takes the role name
+	DeleteRealmRole(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	ListRealmRoleUsers(context.Context, *RoleMembersRequest) (*SearchUsersResponse, error)
+	ListRealmRoleGroups(context.Context, *RoleMembersRequest) (*RoleGroupsResponse, error)
+	ListClientRoles(context.Context, *ListClientRolesRequest) (*RolesPage, error)
+	GetClientRole(context.Context, *ClientRoleRequest) (*Role, error)
+	CreateClientRole(context.Context, *CreateClientRoleRequest) (*Role, error)
+	UpdateClientRole(context.Context, *UpdateClientRoleRequest) (*Role, error)
+	DeleteClientRole(context.Context, *ClientRoleRequest) (*emptypb.Empty, error)
+	ListClientRoleUsers(context.Context, *ClientRoleMembersRequest) (*SearchUsersResponse, error)
+	ListClientRoleGroups(context.Context, *ClientRoleMembersRequest) (*RoleGroupsResponse, error)
+	// maps client roles to a user or group
+	AssignClientRoles(context.Context, *ClientRoleMappingRequest) (*emptypb.Empty, error)
+	RemoveClientRoles(context.Context, *ClientRoleMappingRequest) (*emptypb.Empty, error)
+	ListClientRoleMappings(context.Context, *ListClientRoleMappingsRequest) (*RolesResponse, error)
+	AddCompositeRoles(context.Context, *CompositeRolesRequest) (*emptypb.Empty, error)
+	RemoveCompositeRoles(context.Context, *CompositeRolesRequest) (*emptypb.Empty, error)
+	// lists the direct children of a composite role
+	ListCompositeRoles(context.Context, *RoleRef) (*RolesResponse, error)
+	// the roles a user holds directly, through composite roles and through groups
+	GetEffectiveUserRoles(context.Context, *EffectiveRolesRequest) (*EffectiveRolesResponse, error)
+	mustEmbedUnimplementedRoleAdminServiceServer()
+}
+
+// UnimplementedRoleAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRoleAdminServiceServer struct {
+}
+
+func (UnimplementedRoleAdminServiceServer) ListRealmRoles(context.Context, *ListRolesRequest) (*RolesPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRealmRoles not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) GetRealmRole(context.Context, *wrapperspb.StringValue) (*Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRealmRole not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) CreateRealmRole(context.Context, *Role) (*Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRealmRole not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) UpdateRealmRole(context.Context, *UpdateRoleRequest) (*Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRealmRole not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) DeleteRealmRole(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRealmRole not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) ListRealmRoleUsers(context.Context, *RoleMembersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRealmRoleUsers not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) ListRealmRoleGroups(context.Context, *RoleMembersRequest) (*RoleGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRealmRoleGroups not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) ListClientRoles(context.Context, *ListClientRolesRequest) (*RolesPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientRoles not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) GetClientRole(context.Context, *ClientRoleRequest) (*Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientRole not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) CreateClientRole(context.Context, *CreateClientRoleRequest) (*Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClientRole not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) UpdateClientRole(context.Context, *UpdateClientRoleRequest) (*Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientRole not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) DeleteClientRole(context.Context, *ClientRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientRole not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) ListClientRoleUsers(context.Context, *ClientRoleMembersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientRoleUsers not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) ListClientRoleGroups(context.Context, *ClientRoleMembersRequest) (*RoleGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientRoleGroups not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) AssignClientRoles(context.Context, *ClientRoleMappingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignClientRoles not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) RemoveClientRoles(context.Context, *ClientRoleMappingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveClientRoles not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) ListClientRoleMappings(context.Context, *ListClientRoleMappingsRequest) (*RolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientRoleMappings not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) AddCompositeRoles(context.Context, *CompositeRolesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCompositeRoles not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) RemoveCompositeRoles(context.Context, *CompositeRolesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCompositeRoles not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) ListCompositeRoles(context.Context, *RoleRef) (*RolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompositeRoles not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) GetEffectiveUserRoles(context.Context, *EffectiveRolesRequest) (*EffectiveRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveUserRoles not implemented")
+}
+func (UnimplementedRoleAdminServiceServer) mustEmbedUnimplementedRoleAdminServiceServer() {}
+
+// UnsafeRoleAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoleAdminServiceServer will
+// result in compilation errors.
+type UnsafeRoleAdminServiceServer interface {
+	mustEmbedUnimplementedRoleAdminServiceServer()
+}
+
+func RegisterRoleAdminServiceServer(s grpc.ServiceRegistrar, srv RoleAdminServiceServer) {
+	s.RegisterService(&RoleAdminService_ServiceDesc, srv)
+}
+
+func _RoleAdminService_ListRealmRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).ListRealmRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/ListRealmRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).ListRealmRoles(ctx, req.(*ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_GetRealmRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).GetRealmRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/GetRealmRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).GetRealmRole(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_CreateRealmRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Role)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).CreateRealmRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/CreateRealmRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).CreateRealmRole(ctx, req.(*Role))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_UpdateRealmRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).UpdateRealmRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/UpdateRealmRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).UpdateRealmRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_DeleteRealmRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).DeleteRealmRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/DeleteRealmRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).DeleteRealmRole(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_ListRealmRoleUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).ListRealmRoleUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/ListRealmRoleUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).ListRealmRoleUsers(ctx, req.(*RoleMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_ListRealmRoleGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).ListRealmRoleGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/ListRealmRoleGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).ListRealmRoleGroups(ctx, req.(*RoleMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_ListClientRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).ListClientRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/ListClientRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).ListClientRoles(ctx, req.(*ListClientRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_GetClientRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).GetClientRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/GetClientRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).GetClientRole(ctx, req.(*ClientRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_CreateClientRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).CreateClientRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/CreateClientRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).CreateClientRole(ctx, req.(*CreateClientRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_UpdateClientRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClientRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).UpdateClientRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/UpdateClientRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).UpdateClientRole(ctx, req.(*UpdateClientRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_DeleteClientRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).DeleteClientRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/DeleteClientRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).DeleteClientRole(ctx, req.(*ClientRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_ListClientRoleUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientRoleMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).ListClientRoleUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/ListClientRoleUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).ListClientRoleUsers(ctx, req.(*ClientRoleMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_ListClientRoleGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientRoleMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).ListClientRoleGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/ListClientRoleGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).ListClientRoleGroups(ctx, req.(*ClientRoleMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_AssignClientRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientRoleMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).AssignClientRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/AssignClientRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).AssignClientRoles(ctx, req.(*ClientRoleMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_RemoveClientRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientRoleMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).RemoveClientRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/RemoveClientRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).RemoveClientRoles(ctx, req.(*ClientRoleMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_ListClientRoleMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientRoleMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).ListClientRoleMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/ListClientRoleMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).ListClientRoleMappings(ctx, req.(*ListClientRoleMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_AddCompositeRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompositeRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).AddCompositeRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/AddCompositeRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).AddCompositeRoles(ctx, req.(*CompositeRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_RemoveCompositeRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompositeRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).RemoveCompositeRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/RemoveCompositeRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).RemoveCompositeRoles(ctx, req.(*CompositeRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_ListCompositeRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).ListCompositeRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/ListCompositeRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).ListCompositeRoles(ctx, req.(*RoleRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleAdminService_GetEffectiveUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EffectiveRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleAdminServiceServer).GetEffectiveUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.RoleAdminService/GetEffectiveUserRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleAdminServiceServer).GetEffectiveUserRoles(ctx, req.(*EffectiveRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoleAdminService_ServiceDesc is the grpc.ServiceDesc for RoleAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoleAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "keycloak.ext.RoleAdminService",
+	HandlerType: (*RoleAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRealmRoles",
+			Handler:    _RoleAdminService_ListRealmRoles_Handler,
+		},
+		{
+			MethodName: "GetRealmRole",
+			Handler:    _RoleAdminService_GetRealmRole_Handler,
+		},
+		{
+			MethodName: "CreateRealmRole",
+			Handler:    _RoleAdminService_CreateRealmRole_Handler,
+		},
+		{
+			MethodName: "UpdateRealmRole",
+			Handler:    _RoleAdminService_UpdateRealmRole_Handler,
+		},
+		{
+			MethodName: "DeleteRealmRole",
+			Handler:    _RoleAdminService_DeleteRealmRole_Handler,
+		},
+		{
+			MethodName: "ListRealmRoleUsers",
+			Handler:    _RoleAdminService_ListRealmRoleUsers_Handler,
+		},
+		{
+			MethodName: "ListRealmRoleGroups",
+			Handler:    _RoleAdminService_ListRealmRoleGroups_Handler,
+		},
+		{
+			MethodName: "ListClientRoles",
+			Handler:    _RoleAdminService_ListClientRoles_Handler,
+		},
+		{
+			MethodName: "GetClientRole",
+			Handler:    _RoleAdminService_GetClientRole_Handler,
+		},
+		{
+			MethodName: "CreateClientRole",
+			Handler:    _RoleAdminService_CreateClientRole_Handler,
+		},
+		{
+			MethodName: "UpdateClientRole",
+			Handler:    _RoleAdminService_UpdateClientRole_Handler,
+		},
+		{
+			MethodName: "DeleteClientRole",
+			Handler:    _RoleAdminService_DeleteClientRole_Handler,
+		},
+		{
+			MethodName: "ListClientRoleUsers",
+			Handler:    _RoleAdminService_ListClientRoleUsers_Handler,
+		},
+		{
+			MethodName: "ListClientRoleGroups",
+			Handler:    _RoleAdminService_ListClientRoleGroups_Handler,
+		},
+		{
+			MethodName: "AssignClientRoles",
+			Handler:    _RoleAdminService_AssignClientRoles_Handler,
+		},
+		{
+			MethodName: "RemoveClientRoles",
+			Handler:    _RoleAdminService_RemoveClientRoles_Handler,
+		},
+		{
+			MethodName: "ListClientRoleMappings",
+			Handler:    _RoleAdminService_ListClientRoleMappings_Handler,
+		},
+		{
+			MethodName: "AddCompositeRoles",
+			Handler:    _RoleAdminService_AddCompositeRoles_Handler,
+		},
+		{
+			MethodName: "RemoveCompositeRoles",
+			Handler:    _RoleAdminService_RemoveCompositeRoles_Handler,
+		},
+		{
+			MethodName: "ListCompositeRoles",
+			Handler:    _RoleAdminService_ListCompositeRoles_Handler,
+		},
+		{
+			MethodName: "GetEffectiveUserRoles",
+			Handler:    _RoleAdminService_GetEffectiveUserRoles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "keycloak/ext/roles.proto",
+}
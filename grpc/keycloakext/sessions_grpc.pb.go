@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: keycloak/ext/sessions.proto
+
+package keycloakext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SessionAdminServiceClient is the client API for SessionAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SessionAdminServiceClient interface {
+	ListUserSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*UserSessionsResponse, error)
+	// ends a single active or offline session
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ends every active session of the user, takes the user id. offline sessions are kept
+	LogoutUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// revokes the user's offline tokens and consent for a client
+	RevokeOfflineTokens(ctx context.Context, in *RevokeOfflineTokensRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type sessionAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSessionAdminServiceClient(cc grpc.ClientConnInterface) SessionAdminServiceClient {
+	return &sessionAdminServiceClient{cc}
+}
+
+func (c *sessionAdminServiceClient) ListUserSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*UserSessionsResponse, error) {
+	out := new(UserSessionsResponse)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.SessionAdminService/ListUserSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionAdminServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.SessionAdminService/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionAdminServiceClient) LogoutUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.SessionAdminService/LogoutUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionAdminServiceClient) RevokeOfflineTokens(ctx context.Context, in *RevokeOfflineTokensRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.SessionAdminService/RevokeOfflineTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SessionAdminServiceServer is the server API for SessionAdminService service.
+// All implementations must embed UnimplementedSessionAdminServiceServer
+// for forward compatibility
+type SessionAdminServiceServer interface {
+	ListUserSessions(context.Context, *ListUserSessionsRequest) (*UserSessionsResponse, error)
+	// ends a single active or offline session
+	RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error)
+	// ends every active session of the user, takes the user id. offline sessions are kept
+	LogoutUser(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	// revokes the user's offline tokens and consent for a client
+	RevokeOfflineTokens(context.Context, *RevokeOfflineTokensRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedSessionAdminServiceServer()
+}
+
+// UnimplementedSessionAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSessionAdminServiceServer struct {
+}
+
+func (UnimplementedSessionAdminServiceServer) ListUserSessions(context.Context, *ListUserSessionsRequest) (*UserSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserSessions not implemented")
+}
+func (UnimplementedSessionAdminServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedSessionAdminServiceServer) LogoutUser(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutUser not implemented")
+}
+func (UnimplementedSessionAdminServiceServer) RevokeOfflineTokens(context.Context, *RevokeOfflineTokensRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOfflineTokens not implemented")
+}
+func (UnimplementedSessionAdminServiceServer) mustEmbedUnimplementedSessionAdminServiceServer() {}
+
+// UnsafeSessionAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SessionAdminServiceServer will
+// result in compilation errors.
+type UnsafeSessionAdminServiceServer interface {
+	mustEmbedUnimplementedSessionAdminServiceServer()
+}
+
+func RegisterSessionAdminServiceServer(s grpc.ServiceRegistrar, srv SessionAdminServiceServer) {
+	s.RegisterService(&SessionAdminService_ServiceDesc, srv)
+}
+
+func _SessionAdminService_ListUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionAdminServiceServer).ListUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.SessionAdminService/ListUserSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionAdminServiceServer).ListUserSessions(ctx, req.(*ListUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionAdminService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionAdminServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.SessionAdminService/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionAdminServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionAdminService_LogoutUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionAdminServiceServer).LogoutUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.SessionAdminService/LogoutUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionAdminServiceServer).LogoutUser(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionAdminService_RevokeOfflineTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeOfflineTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionAdminServiceServer).RevokeOfflineTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.SessionAdminService/RevokeOfflineTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionAdminServiceServer).RevokeOfflineTokens(ctx, req.(*RevokeOfflineTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SessionAdminService_ServiceDesc is the grpc.ServiceDesc for SessionAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SessionAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "keycloak.ext.SessionAdminService",
+	HandlerType: (*SessionAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUserSessions",
+			Handler:    _SessionAdminService_ListUserSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _SessionAdminService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "LogoutUser",
+			Handler:    _SessionAdminService_LogoutUser_Handler,
+		},
+		{
+			MethodName: "RevokeOfflineTokens",
+			Handler:    _SessionAdminService_RevokeOfflineTokens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "keycloak/ext/sessions.proto",
+}
@@ -0,0 +1,479 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: keycloak/ext/users.proto
+
+package keycloakext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// UserAdminServiceClient is the client API for UserAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserAdminServiceClient interface {
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	CountUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*wrapperspb.Int32Value, error)
+	BatchGetUsersByIds(ctx context.Context, in *BatchGetUsersRequest, opts ...grpc.CallOption) (*BatchUsersResponse, error)
+	BatchGetUsersByUsernames(ctx context.Context, in *BatchGetUsersRequest, opts ...grpc.CallOption) (*BatchUsersResponse, error)
+	// looks a user up by id or username, like GetUserById and GetUserByUsername, with optional expansion
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserDetails, error)
+	// updates exactly the fields named in updateMask
+	PatchUser(ctx context.Context, in *PatchUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// the required actions registered in the realm, only enabled ones can be set on users
+	ListRequiredActions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RequiredActionsResponse, error)
+	// replaces the user's required actions
+	SetRequiredActions(ctx context.Context, in *RequiredActionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// takes the user id
+	ClearRequiredActions(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// emails the user a link to perform the given actions
+	ExecuteActionsEmail(ctx context.Context, in *ExecuteActionsEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SendVerifyEmail(ctx context.Context, in *SendVerifyEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type userAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserAdminServiceClient(cc grpc.ClientConnInterface) UserAdminServiceClient {
+	return &userAdminServiceClient{cc}
+}
+
+func (c *userAdminServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.UserAdminService/SearchUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminServiceClient) CountUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*wrapperspb.Int32Value, error) {
+	out := new(wrapperspb.Int32Value)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.UserAdminService/CountUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminServiceClient) BatchGetUsersByIds(ctx context.Context, in *BatchGetUsersRequest, opts ...grpc.CallOption) (*BatchUsersResponse, error) {
+	out := new(BatchUsersResponse)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.UserAdminService/BatchGetUsersByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminServiceClient) BatchGetUsersByUsernames(ctx context.Context, in *BatchGetUsersRequest, opts ...grpc.CallOption) (*BatchUsersResponse, error) {
+	out := new(BatchUsersResponse)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.UserAdminService/BatchGetUsersByUsernames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserDetails, error) {
+	out := new(UserDetails)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.UserAdminService/GetUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminServiceClient) PatchUser(ctx context.Context, in *PatchUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.UserAdminService/PatchUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminServiceClient) ListRequiredActions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RequiredActionsResponse, error) {
+	out := new(RequiredActionsResponse)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.UserAdminService/ListRequiredActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminServiceClient) SetRequiredActions(ctx context.Context, in *RequiredActionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.UserAdminService/SetRequiredActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminServiceClient) ClearRequiredActions(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.UserAdminService/ClearRequiredActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminServiceClient) ExecuteActionsEmail(ctx context.Context, in *ExecuteActionsEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.UserAdminService/ExecuteActionsEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAdminServiceClient) SendVerifyEmail(ctx context.Context, in *SendVerifyEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/keycloak.ext.UserAdminService/SendVerifyEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserAdminServiceServer is the server API for UserAdminService service.
+// All implementations must embed UnimplementedUserAdminServiceServer
+// for forward compatibility
+type UserAdminServiceServer interface {
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	CountUsers(context.Context, *SearchUsersRequest) (*wrapperspb.Int32Value, error)
+	BatchGetUsersByIds(context.Context, *BatchGetUsersRequest) (*BatchUsersResponse, error)
+	BatchGetUsersByUsernames(context.Context, *BatchGetUsersRequest) (*BatchUsersResponse, error)
+	// looks a user up by id or username, like GetUserById and GetUserByUsername, with optional expansion
+	GetUser(context.Context, *GetUserRequest) (*UserDetails, error)
+	// updates exactly the fields named in updateMask
+	PatchUser(context.Context, *PatchUserRequest) (*emptypb.Empty, error)
+	// the required actions registered in the realm, only enabled ones can be set on users
+	ListRequiredActions(context.Context, *emptypb.Empty) (*RequiredActionsResponse, error)
+	// replaces the user's required actions
+	SetRequiredActions(context.Context, *RequiredActionsRequest) (*emptypb.Empty, error)
+	// takes the user id
+	ClearRequiredActions(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
+	// emails the user a link to perform the given actions
+	ExecuteActionsEmail(context.Context, *ExecuteActionsEmailRequest) (*emptypb.Empty, error)
+	SendVerifyEmail(context.Context, *SendVerifyEmailRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedUserAdminServiceServer()
+}
+
+// UnimplementedUserAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUserAdminServiceServer struct {
+}
+
+func (UnimplementedUserAdminServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedUserAdminServiceServer) CountUsers(context.Context, *SearchUsersRequest) (*wrapperspb.Int32Value, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountUsers not implemented")
+}
+func (UnimplementedUserAdminServiceServer) BatchGetUsersByIds(context.Context, *BatchGetUsersRequest) (*BatchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetUsersByIds not implemented")
+}
+func (UnimplementedUserAdminServiceServer) BatchGetUsersByUsernames(context.Context, *BatchGetUsersRequest) (*BatchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetUsersByUsernames not implemented")
+}
+func (UnimplementedUserAdminServiceServer) GetUser(context.Context, *GetUserRequest) (*UserDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUserAdminServiceServer) PatchUser(context.Context, *PatchUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchUser not implemented")
+}
+func (UnimplementedUserAdminServiceServer) ListRequiredActions(context.Context, *emptypb.Empty) (*RequiredActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRequiredActions not implemented")
+}
+func (UnimplementedUserAdminServiceServer) SetRequiredActions(context.Context, *RequiredActionsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRequiredActions not implemented")
+}
+func (UnimplementedUserAdminServiceServer) ClearRequiredActions(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearRequiredActions not implemented")
+}
+func (UnimplementedUserAdminServiceServer) ExecuteActionsEmail(context.Context, *ExecuteActionsEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteActionsEmail not implemented")
+}
+func (UnimplementedUserAdminServiceServer) SendVerifyEmail(context.Context, *SendVerifyEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendVerifyEmail not implemented")
+}
+func (UnimplementedUserAdminServiceServer) mustEmbedUnimplementedUserAdminServiceServer() {}
+
+// UnsafeUserAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserAdminServiceServer will
+// result in compilation errors.
+type UnsafeUserAdminServiceServer interface {
+	mustEmbedUnimplementedUserAdminServiceServer()
+}
+
+func RegisterUserAdminServiceServer(s grpc.ServiceRegistrar, srv UserAdminServiceServer) {
+	s.RegisterService(&UserAdminService_ServiceDesc, srv)
+}
+
+func _UserAdminService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.UserAdminService/SearchUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdminService_CountUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServiceServer).CountUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.UserAdminService/CountUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServiceServer).CountUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdminService_BatchGetUsersByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServiceServer).BatchGetUsersByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.UserAdminService/BatchGetUsersByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServiceServer).BatchGetUsersByIds(ctx, req.(*BatchGetUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdminService_BatchGetUsersByUsernames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServiceServer).BatchGetUsersByUsernames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.UserAdminService/BatchGetUsersByUsernames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServiceServer).BatchGetUsersByUsernames(ctx, req.(*BatchGetUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdminService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.UserAdminService/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdminService_PatchUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServiceServer).PatchUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.UserAdminService/PatchUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServiceServer).PatchUser(ctx, req.(*PatchUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdminService_ListRequiredActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServiceServer).ListRequiredActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.UserAdminService/ListRequiredActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServiceServer).ListRequiredActions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdminService_SetRequiredActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequiredActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServiceServer).SetRequiredActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.UserAdminService/SetRequiredActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServiceServer).SetRequiredActions(ctx, req.(*RequiredActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdminService_ClearRequiredActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServiceServer).ClearRequiredActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.UserAdminService/ClearRequiredActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServiceServer).ClearRequiredActions(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdminService_ExecuteActionsEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteActionsEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServiceServer).ExecuteActionsEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.UserAdminService/ExecuteActionsEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServiceServer).ExecuteActionsEmail(ctx, req.(*ExecuteActionsEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAdminService_SendVerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAdminServiceServer).SendVerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keycloak.ext.UserAdminService/SendVerifyEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAdminServiceServer).SendVerifyEmail(ctx, req.(*SendVerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserAdminService_ServiceDesc is the grpc.ServiceDesc for UserAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "keycloak.ext.UserAdminService",
+	HandlerType: (*UserAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UserAdminService_SearchUsers_Handler,
+		},
+		{
+			MethodName: "CountUsers",
+			Handler:    _UserAdminService_CountUsers_Handler,
+		},
+		{
+			MethodName: "BatchGetUsersByIds",
+			Handler:    _UserAdminService_BatchGetUsersByIds_Handler,
+		},
+		{
+			MethodName: "BatchGetUsersByUsernames",
+			Handler:    _UserAdminService_BatchGetUsersByUsernames_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _UserAdminService_GetUser_Handler,
+		},
+		{
+			MethodName: "PatchUser",
+			Handler:    _UserAdminService_PatchUser_Handler,
+		},
+		{
+			MethodName: "ListRequiredActions",
+			Handler:    _UserAdminService_ListRequiredActions_Handler,
+		},
+		{
+			MethodName: "SetRequiredActions",
+			Handler:    _UserAdminService_SetRequiredActions_Handler,
+		},
+		{
+			MethodName: "ClearRequiredActions",
+			Handler:    _UserAdminService_ClearRequiredActions_Handler,
+		},
+		{
+			MethodName: "ExecuteActionsEmail",
+			Handler:    _UserAdminService_ExecuteActionsEmail_Handler,
+		},
+		{
+			MethodName: "SendVerifyEmail",
+			Handler:    _UserAdminService_SendVerifyEmail_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "keycloak/ext/users.proto",
+}
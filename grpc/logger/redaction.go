@@ -0,0 +1,109 @@
+package logger
+
+import (
+	"encoding/json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// SecretFields are always redacted from logged requests and responses.
+var SecretFields = []string{
+	"password",
+	"newPassword",
+	"secret",
+	"clientSecret",
+	"token",
+	"accessToken",
+	"refreshToken",
+	"idToken",
+	"authorization",
+}
+
+// RedactionPolicy decides which message fields are hidden before a payload is
+// logged. A rule is a field name, matched at any depth, or a dotted path such
+// as "attributes.phoneNumber" matched against the end of the field path.
+// Names are compared case-insensitively, ignoring underscores.
+type RedactionPolicy struct {
+	// Fields are redacted on top of SecretFields, typically PII such as email.
+	Fields []string
+}
+
+// Redact returns the message as a JSON compatible value with the redacted
+// fields replaced. Values that are not protobuf messages are not logged.
+func (p RedactionPolicy) Redact(message interface{}) interface{} {
+	msg, ok := message.(proto.Message)
+	if !ok || msg == nil {
+		return nil
+	}
+
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil
+	}
+
+	var value interface{}
+	if err = json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+
+	return p.redact(nil, value, p.rules())
+}
+
+func (p RedactionPolicy) rules() [][]string {
+	rules := make([][]string, 0, len(SecretFields)+len(p.Fields))
+	for _, field := range append(SecretFields[:len(SecretFields):len(SecretFields)], p.Fields...) {
+		var rule []string
+		for _, name := range strings.Split(field, ".") {
+			rule = append(rule, normalizeFieldName(name))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func (p RedactionPolicy) redact(path []string, value interface{}, rules [][]string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			fieldPath := append(path[:len(path):len(path)], normalizeFieldName(key))
+			if matchesAny(fieldPath, rules) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = p.redact(fieldPath, field, rules)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = p.redact(path, item, rules)
+		}
+	}
+	return value
+}
+
+func matchesAny(path []string, rules [][]string) bool {
+	for _, rule := range rules {
+		if len(rule) > len(path) {
+			continue
+		}
+
+		matches := true
+		offset := len(path) - len(rule)
+		for i, name := range rule {
+			if path[offset+i] != name {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), "_", ""))
+}
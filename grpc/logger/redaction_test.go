@@ -0,0 +1,105 @@
+package logger
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	user "github.com/hub1989/keycloak-protobuf/golang/keycloak"
+	"google.golang.org/protobuf/proto"
+	"reflect"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	policy := RedactionPolicy{Fields: []string{"email", "attributes.phoneNumber"}}
+
+	tests := []struct {
+		name    string
+		message interface{}
+		want    interface{}
+	}{
+		{
+			name: "credentials",
+			message: &user.AuthenticateRequest{
+				Username:     "jane",
+				Password:     "secret",
+				ClientId:     &wrappers.StringValue{Value: "app"},
+				ClientSecret: &wrappers.StringValue{Value: "client-secret"},
+			},
+			want: map[string]interface{}{
+				"username":     "jane",
+				"password":     redacted,
+				"clientId":     "app",
+				"clientSecret": redacted,
+			},
+		},
+		{
+			name: "tokens",
+			message: &user.AccessTokenResponse{
+				AccessToken:  "access",
+				RefreshToken: "refresh",
+				IdToken:      "id",
+				TokenType:    "Bearer",
+				ExpiresIn:    300,
+			},
+			want: map[string]interface{}{
+				"accessToken":  redacted,
+				"RefreshToken": redacted,
+				"IdToken":      redacted,
+				"TokenType":    "Bearer",
+				"ExpiresIn":    float64(300),
+			},
+		},
+		{
+			name: "dotted rule",
+			message: &user.UserRequest{
+				Username:    "jane",
+				PhoneNumber: &wrappers.StringValue{Value: "+49 30 1234"},
+				Attributes:  map[string]string{"phoneNumber": "+49 30 1234", "team": "a"},
+			},
+			want: map[string]interface{}{
+				"username":    "jane",
+				"phoneNumber": "+49 30 1234",
+				"attributes":  map[string]interface{}{"phoneNumber": redacted, "team": "a"},
+			},
+		},
+		{
+			name: "pii at any depth",
+			message: &user.UsersResponse{Users: []*user.UserResponse{
+				{Username: "jane", Email: "jane@example.com"},
+				{Username: "john", Email: "john@example.com"},
+			}},
+			want: map[string]interface{}{
+				"users": []interface{}{
+					map[string]interface{}{"username": "jane", "email": redacted},
+					map[string]interface{}{"username": "john", "email": redacted},
+				},
+			},
+		},
+		{
+			name:    "not a message",
+			message: "password",
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.Redact(tt.message); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Redact() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactKeepsMessage(t *testing.T) {
+	request := &user.AuthenticateRequest{
+		Username:     "jane",
+		Password:     "secret",
+		ClientSecret: &wrappers.StringValue{Value: "client-secret"},
+	}
+	original := proto.Clone(request)
+
+	RedactionPolicy{}.Redact(request)
+
+	if !proto.Equal(request, original) {
+		t.Errorf("Redact() changed the message to %v", request)
+	}
+}
@@ -2,17 +2,100 @@ package logger
 
 import (
 	"context"
+	"github.com/hub1989/keycloak-grpc-service/grpc/auth"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"time"
 )
 
-func ServerLogger(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	log.WithField("method", info.FullMethod).Info("incoming gRpc request")
+// ServerLogger logs one line per handled call with its duration, status code,
+// peer, authenticated subject and trace ids. At debug level the request and
+// response are logged as well, with the fields of Redaction hidden.
+//
+// It has to run after the tracing interceptor so the span is in the context,
+// and before the authentication interceptor so rejected calls are logged too.
+type ServerLogger struct {
+	Redaction RedactionPolicy
+}
+
+func (l ServerLogger) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	ctx, caller := auth.WithCaller(ctx)
 	resp, err := handler(ctx, req)
-	if err != nil {
-		log.WithFields(log.Fields{
-			"method": info.FullMethod,
-		}).WithError(err).Error("method failed")
+
+	entry := l.entry(ctx, caller, info.FullMethod, start, err)
+	if log.IsLevelEnabled(log.DebugLevel) {
+		entry = entry.WithField("request", l.Redaction.Redact(req))
+		if err == nil {
+			entry = entry.WithField("response", l.Redaction.Redact(resp))
+		}
 	}
+	logCall(entry, err)
+
 	return resp, err
 }
+
+func (l ServerLogger) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	ctx, caller := auth.WithCaller(ss.Context())
+	err := handler(srv, &callerStream{ServerStream: ss, ctx: ctx})
+
+	logCall(l.entry(ctx, caller, info.FullMethod, start, err), err)
+	return err
+}
+
+func (l ServerLogger) entry(ctx context.Context, caller *auth.Caller, method string, start time.Time, err error) *log.Entry {
+	fields := log.Fields{
+		"method":      method,
+		"code":        status.Code(err).String(),
+		"duration_ms": float64(time.Since(start).Microseconds()) / 1000,
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		fields["peer"] = p.Addr.String()
+	}
+	if claims, ok := caller.Claims(); ok {
+		if claims.Subject != "" {
+			fields["subject"] = claims.Subject
+		}
+		if claims.AuthorizedParty != "" {
+			fields["client_id"] = claims.AuthorizedParty
+		}
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		fields["trace_id"] = spanContext.TraceID().String()
+		fields["span_id"] = spanContext.SpanID().String()
+	}
+
+	return log.WithContext(ctx).WithFields(fields)
+}
+
+// logCall logs failures caused by this service or Keycloak as errors and
+// rejected calls as warnings.
+func logCall(entry *log.Entry, err error) {
+	if err == nil {
+		entry.Info("handled gRpc request")
+		return
+	}
+
+	entry = entry.WithError(err)
+	switch status.Code(err) {
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss, codes.DeadlineExceeded, codes.Unimplemented:
+		entry.Error("method failed")
+	default:
+		entry.Warn("method failed")
+	}
+}
+
+type callerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *callerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"context"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"github.com/hub1989/keycloak-grpc-service/grpc/auth"
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+type staticVerifier struct {
+	claims domain.TokenClaims
+}
+
+func (v staticVerifier) VerifyToken(ctx context.Context, rawToken string) (domain.TokenClaims, error) {
+	if rawToken != "valid" {
+		return domain.TokenClaims{}, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return v.claims, nil
+}
+
+func (v staticVerifier) VerifyTokenForAudience(ctx context.Context, rawToken, audience string) (domain.TokenClaims, error) {
+	return v.VerifyToken(ctx, rawToken)
+}
+
+func TestServerLoggerRunsBeforeAuthentication(t *testing.T) {
+	hook := test.NewGlobal()
+	defer hook.Reset()
+
+	authenticator := auth.Authenticator{TokenVerifier: staticVerifier{claims: domain.TokenClaims{Subject: "user-1"}}}
+	logger := ServerLogger{}
+	info := &grpc.UnaryServerInfo{FullMethod: "/keycloak.UserService/GetUserById"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	// the logger wraps the authenticator, as in the server's interceptor chain
+	call := func(token string) *log.Entry {
+		hook.Reset()
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		_, _ = logger.Unary(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return authenticator.Unary(ctx, req, info, handler)
+		})
+		return hook.LastEntry()
+	}
+
+	rejected := call("invalid")
+	if rejected == nil {
+		t.Fatal("rejected call was not logged")
+	}
+	if rejected.Data["code"] != codes.Unauthenticated.String() {
+		t.Errorf("code = %v, want Unauthenticated", rejected.Data["code"])
+	}
+	if _, ok := rejected.Data["subject"]; ok {
+		t.Errorf("rejected call logged subject %v", rejected.Data["subject"])
+	}
+
+	handled := call("valid")
+	if handled == nil || handled.Data["subject"] != "user-1" {
+		t.Errorf("handled call logged %v, want subject user-1", handled)
+	}
+}
@@ -0,0 +1,159 @@
+package keycloak
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hub1989/keycloak-grpc-service/metrics"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// adminClient performs the request/response plumbing shared by every call to the Keycloak admin API
+// and the realm's OIDC endpoints.
+type adminClient struct {
+	Configuration
+}
+
+type adminRequest struct {
+	// op describes the call in logs and errors, e.g. "create user".
+	op       string
+	method   string
+	endpoint string
+	query    url.Values
+	body     interface{}
+	// form is sent url-encoded instead of body, as the OIDC endpoints expect.
+	form url.Values
+	// text is sent as text/plain instead of body.
+	text string
+	// result, when set, receives the decoded JSON response body.
+	result interface{}
+	// serviceAccount marks requests that authenticate with the service's own
+	// client credentials instead of anything the caller sent.
+	serviceAccount bool
+}
+
+func newAdminClient(configuration Configuration) adminClient {
+	return adminClient{Configuration: configuration}
+}
+
+func (a adminClient) do(ctx context.Context, token string, request adminRequest) error {
+	if err := checkPath(request.endpoint); err != nil {
+		return fmt.Errorf("could not %s: %w", request.op, err)
+	}
+
+	endpoint := request.endpoint
+	if len(request.query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, request.query.Encode())
+	}
+
+	var bodyReader io.Reader
+	contentType := "application/json"
+	if request.form != nil {
+		bodyReader = strings.NewReader(request.form.Encode())
+		contentType = "application/x-www-form-urlencoded"
+	} else if request.text != "" {
+		bodyReader = strings.NewReader(request.text)
+		contentType = "text/plain"
+	} else if request.body != nil {
+		body, err := json.Marshal(request.body)
+		if err != nil {
+			return fmt.Errorf("could not %s: %w", request.op, err)
+		}
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(metrics.WithOperation(ctx, request.op), request.method, endpoint, bodyReader)
+	if err != nil {
+		return fmt.Errorf("could not %s: %w", request.op, err)
+	}
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	req.Header.Set("Accept", "application/json")
+	if bodyReader != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	res, err := a.GetClient().Do(req)
+	if err != nil {
+		log.WithError(err).Error("could not " + request.op)
+		return fmt.Errorf("could not %s: %w", request.op, err)
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("could not %s: %w", request.op, err)
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		err = newResponseError(request.op, res, data)
+		if rejectsServiceAccount(request, res.StatusCode) {
+			err = fmt.Errorf("%w: %w", ErrServiceAccount, err)
+		}
+		log.WithFields(log.Fields{
+			"method":   request.method,
+			"endpoint": request.endpoint,
+			"status":   res.StatusCode,
+		}).WithError(err).Error("could not " + request.op)
+		return err
+	}
+
+	if request.result != nil && len(data) > 0 {
+		if err = json.Unmarshal(data, request.result); err != nil {
+			return fmt.Errorf("could not %s: %w", request.op, err)
+		}
+	}
+
+	return nil
+}
+
+// rejectsServiceAccount reports whether Keycloak refused the service's own
+// credentials rather than anything the caller sent: the admin API is only
+// called with the service-account token, and the other requests say so.
+func rejectsServiceAccount(request adminRequest, statusCode int) bool {
+	if statusCode != http.StatusUnauthorized {
+		return false
+	}
+	return request.serviceAccount || strings.Contains(request.endpoint, "/admin/realms/")
+}
+
+// escapedPath appends segments to base, escaping each one so an id can never
+// add path segments of its own.
+func escapedPath(base string, segments ...string) string {
+	var b strings.Builder
+	b.WriteString(base)
+	for _, segment := range segments {
+		b.WriteByte('/')
+		b.WriteString(url.PathEscape(segment))
+	}
+	return b.String()
+}
+
+// checkPath rejects empty and dot segments. Keycloak decodes %2E before it
+// resolves dot segments, so escaping alone cannot keep an id such as ".."
+// from reaching another resource.
+func checkPath(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid path: %w", ErrValidationFailed)
+	}
+
+	segments := strings.Split(u.EscapedPath(), "/")
+	for i, segment := range segments {
+		if i == 0 {
+			continue
+		}
+		decoded, err := url.PathUnescape(segment)
+		if err != nil || decoded == "" || decoded == "." || decoded == ".." {
+			return fmt.Errorf("invalid path segment %q: %w", segment, ErrValidationFailed)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestEscapedPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments []string
+		want     string
+	}{
+		{"plain id", []string{"1234", "groups"}, "http://kc/admin/realms/r/users/1234/groups"},
+		{"slash in id", []string{"a/b"}, "http://kc/admin/realms/r/users/a%2Fb"},
+		{"query in id", []string{"a?b=c"}, "http://kc/admin/realms/r/users/a%3Fb=c"},
+		{"space in id", []string{"a b"}, "http://kc/admin/realms/r/users/a%20b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapedPath("http://kc/admin/realms/r/users", tt.segments...); got != tt.want {
+				t.Errorf("escapedPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantErr bool
+	}{
+		{"plain id", "1234", false},
+		{"escaped slash", "a/b", false},
+		{"empty", "", true},
+		{"dot", ".", true},
+		{"dot dot", "..", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkPath(escapedPath("http://kc/admin/realms/r/users", tt.id, "groups"))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrValidationFailed) {
+				t.Errorf("checkPath() error = %v, want ErrValidationFailed", err)
+			}
+		})
+	}
+}
+
+func TestUnauthorizedServiceAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"` + r.URL.Query().Get("error") + `"}`))
+	}))
+	defer server.Close()
+
+	configuration := DefaultKeycloakConfiguration{BaseURL: server.URL, Realm: "test", Client: server.Client()}
+	tests := []struct {
+		name    string
+		request adminRequest
+		want    bool
+	}{
+		{"admin api", adminRequest{endpoint: configuration.GetUserEndpoint()}, true},
+		{"service client", adminRequest{
+			endpoint:       escapedPath(configuration.GetOpenIdConnectEndpoint(), "token"),
+			query:          url.Values{"error": {"invalid_client"}},
+			form:           url.Values{"client_secret": {"secret"}},
+			serviceAccount: true,
+		}, true},
+		{"caller client", adminRequest{
+			endpoint: escapedPath(configuration.GetOpenIdConnectEndpoint(), "token"),
+			query:    url.Values{"error": {"invalid_client"}},
+			form:     url.Values{"client_secret": {"secret"}},
+		}, false},
+		{"user credentials", adminRequest{
+			endpoint: escapedPath(configuration.GetOpenIdConnectEndpoint(), "token"),
+			query:    url.Values{"error": {"invalid_grant"}},
+			form:     url.Values{"client_secret": {"secret"}},
+		}, false},
+		{"user token", adminRequest{endpoint: escapedPath(configuration.GetOpenIdConnectEndpoint(), "userinfo")}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.request.op = "call keycloak"
+			tt.request.method = http.MethodPost
+			err := newAdminClient(configuration).do(context.Background(), "token", tt.request)
+			if !errors.Is(err, ErrUnauthorized) {
+				t.Fatalf("error = %v, want ErrUnauthorized", err)
+			}
+			if got := errors.Is(err, ErrServiceAccount); got != tt.want {
+				t.Errorf("errors.Is(err, ErrServiceAccount) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"sync"
+)
+
+const (
+	defaultBatchConcurrency = 10
+	defaultMaxBatchSize     = 100
+)
+
+// fetchUsers looks up each distinct key with at most limit requests in flight.
+// Users are returned in input order; keys Keycloak does not know are returned
+// separately. Any other failure aborts the whole batch. More than maxSize keys
+// are rejected before any request is sent.
+func fetchUsers(ctx context.Context, keys []string, limit, maxSize int, fetch func(ctx context.Context, key string) (domain.UserRepresentation, error)) ([]domain.UserRepresentation, []string, error) {
+	if maxSize <= 0 {
+		maxSize = defaultMaxBatchSize
+	}
+	if len(keys) > maxSize {
+		return nil, nil, fmt.Errorf("batch of %d exceeds the limit of %d: %w", len(keys), maxSize, ErrValidationFailed)
+	}
+
+	var unique []string
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, key)
+		}
+	}
+
+	users := make([]domain.UserRepresentation, len(unique))
+	found := make([]bool, len(unique))
+
+	err := forEach(ctx, len(unique), limit, func(ctx context.Context, i int) error {
+		user, err := fetch(ctx, unique[i])
+		switch {
+		case err == nil:
+			users[i], found[i] = user, true
+		case errors.Is(err, ErrNotFound):
+		default:
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result []domain.UserRepresentation
+	var notFound []string
+	for i, key := range unique {
+		if found[i] {
+			result = append(result, users[i])
+		} else {
+			notFound = append(notFound, key)
+		}
+	}
+
+	return result, notFound, nil
+}
+
+// forEach calls fn for every index below n with at most limit calls in
+// flight. The first failure cancels the calls still running and is returned.
+func forEach(ctx context.Context, n, limit int, fn func(ctx context.Context, i int) error) error {
+	if limit <= 0 {
+		limit = defaultBatchConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	semaphore := make(chan struct{}, limit)
+
+	for i := 0; i < n; i++ {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := fn(ctx, i); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
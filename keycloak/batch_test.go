@@ -0,0 +1,52 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFetchUsers(t *testing.T) {
+	var calls atomic.Int32
+	fetch := func(ctx context.Context, key string) (domain.UserRepresentation, error) {
+		calls.Add(1)
+		if key == "missing" {
+			return domain.UserRepresentation{}, ErrNotFound
+		}
+		return domain.UserRepresentation{Id: key}, nil
+	}
+
+	users, notFound, err := fetchUsers(context.Background(), []string{"b", "a", "missing", "b"}, 2, 4, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for _, user := range users {
+		ids = append(ids, user.Id)
+	}
+	if !reflect.DeepEqual(ids, []string{"b", "a"}) {
+		t.Errorf("users = %v, want [b a]", ids)
+	}
+	if !reflect.DeepEqual(notFound, []string{"missing"}) {
+		t.Errorf("notFound = %v, want [missing]", notFound)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("fetch calls = %d, want 3", got)
+	}
+}
+
+func TestFetchUsersRejectsOversizedBatch(t *testing.T) {
+	fetch := func(ctx context.Context, key string) (domain.UserRepresentation, error) {
+		t.Fatal("fetch called for an oversized batch")
+		return domain.UserRepresentation{}, nil
+	}
+
+	_, _, err := fetchUsers(context.Background(), []string{"a", "b", "c"}, 2, 2, fetch)
+	if !errors.Is(err, ErrValidationFailed) {
+		t.Fatalf("error = %v, want ErrValidationFailed", err)
+	}
+}
@@ -0,0 +1,175 @@
+package keycloak
+
+import (
+	"context"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"net/http"
+	"strconv"
+)
+
+func (d DefaultRoleService) GetClientRoles(ctx context.Context, clientId string, search domain.RoleSearch, token string) ([]domain.Role, error) {
+	query := pageQuery(search.First, search.Max)
+	query.Set("briefRepresentation", strconv.FormatBool(search.Brief))
+	if search.Search != "" {
+		query.Set("search", search.Search)
+	}
+
+	var roles []domain.Role
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get client roles",
+		method:   http.MethodGet,
+		endpoint: d.clientRolesEndpoint(clientId),
+		query:    query,
+		result:   &roles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (d DefaultRoleService) GetClientRole(ctx context.Context, clientId, name string, token string) (domain.Role, error) {
+	var role domain.Role
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get client role",
+		method:   http.MethodGet,
+		endpoint: d.clientRoleEndpoint(clientId, name),
+		result:   &role,
+	})
+	if err != nil {
+		return domain.Role{}, err
+	}
+
+	return role, nil
+}
+
+func (d DefaultRoleService) CreateClientRole(ctx context.Context, clientId string, role domain.Role, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "create client role",
+		method:   http.MethodPost,
+		endpoint: d.clientRolesEndpoint(clientId),
+		body:     role,
+	})
+	return err
+}
+
+func (d DefaultRoleService) UpdateClientRole(ctx context.Context, clientId, name string, role domain.Role, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "update client role",
+		method:   http.MethodPut,
+		endpoint: d.clientRoleEndpoint(clientId, name),
+		body:     role,
+	})
+	return err
+}
+
+func (d DefaultRoleService) DeleteClientRole(ctx context.Context, clientId, name string, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "delete client role",
+		method:   http.MethodDelete,
+		endpoint: d.clientRoleEndpoint(clientId, name),
+	})
+	return err
+}
+
+func (d DefaultRoleService) GetClientRoleUsers(ctx context.Context, clientId, name string, first, max int, token string) ([]domain.UserRepresentation, error) {
+	var users []domain.UserRepresentation
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get client role users",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.clientRoleEndpoint(clientId, name), "users"),
+		query:    pageQuery(first, max),
+		result:   &users,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (d DefaultRoleService) GetClientRoleGroups(ctx context.Context, clientId, name string, first, max int, token string) ([]domain.GroupOverview, error) {
+	var groups []domain.GroupOverview
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get client role groups",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.clientRoleEndpoint(clientId, name), "groups"),
+		query:    pageQuery(first, max),
+		result:   &groups,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+func (d DefaultRoleService) AssignClientRolesToUser(ctx context.Context, userId, clientId string, roles []domain.Role, token string) error {
+	return d.changeRoleMappings(ctx, "assign client roles to user", http.MethodPost, d.userClientMappingsEndpoint(userId, clientId), roles, token)
+}
+
+func (d DefaultRoleService) RemoveClientRolesFromUser(ctx context.Context, userId, clientId string, roles []domain.Role, token string) error {
+	return d.changeRoleMappings(ctx, "remove client roles from user", http.MethodDelete, d.userClientMappingsEndpoint(userId, clientId), roles, token)
+}
+
+func (d DefaultRoleService) GetUserClientRoles(ctx context.Context, userId, clientId string, view RoleMappingView, token string) ([]domain.Role, error) {
+	return d.roleMappings(ctx, "get user client roles", d.userClientMappingsEndpoint(userId, clientId), view, token)
+}
+
+func (d DefaultRoleService) AssignClientRolesToGroup(ctx context.Context, groupId, clientId string, roles []domain.Role, token string) error {
+	return d.changeRoleMappings(ctx, "assign client roles to group", http.MethodPost, d.groupClientMappingsEndpoint(groupId, clientId), roles, token)
+}
+
+func (d DefaultRoleService) RemoveClientRolesFromGroup(ctx context.Context, groupId, clientId string, roles []domain.Role, token string) error {
+	return d.changeRoleMappings(ctx, "remove client roles from group", http.MethodDelete, d.groupClientMappingsEndpoint(groupId, clientId), roles, token)
+}
+
+func (d DefaultRoleService) GetGroupClientRoles(ctx context.Context, groupId, clientId string, view RoleMappingView, token string) ([]domain.Role, error) {
+	return d.roleMappings(ctx, "get group client roles", d.groupClientMappingsEndpoint(groupId, clientId), view, token)
+}
+
+func (d DefaultRoleService) changeRoleMappings(ctx context.Context, op, method, endpoint string, roles []domain.Role, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       op,
+		method:   method,
+		endpoint: endpoint,
+		body:     roles,
+	})
+	return err
+}
+
+func (d DefaultRoleService) roleMappings(ctx context.Context, op, endpoint string, view RoleMappingView, token string) ([]domain.Role, error) {
+	if view != AssignedRoles {
+		endpoint = escapedPath(endpoint, string(view))
+	}
+
+	var roles []domain.Role
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       op,
+		method:   http.MethodGet,
+		endpoint: endpoint,
+		result:   &roles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (d DefaultRoleService) clientRolesEndpoint(clientId string) string {
+	return escapedPath(d.GetClientEndpoint(), clientId, "roles")
+}
+
+func (d DefaultRoleService) clientRoleEndpoint(clientId, name string) string {
+	return escapedPath(d.clientRolesEndpoint(clientId), name)
+}
+
+func (d DefaultRoleService) userClientMappingsEndpoint(userId, clientId string) string {
+	return escapedPath(d.GetUserEndpoint(), userId, "role-mappings", "clients", clientId)
+}
+
+func (d DefaultRoleService) groupClientMappingsEndpoint(groupId, clientId string) string {
+	return escapedPath(d.GetGroupEndpoint(), groupId, "role-mappings", "clients", clientId)
+}
@@ -2,12 +2,8 @@ package keycloak
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"github.com/hub1989/keycloak-grpc-service/domain"
-	log "github.com/sirupsen/logrus"
-	"io"
 	"net/http"
 	"strings"
 )
@@ -25,39 +21,17 @@ type DefaultClientService struct {
 }
 
 func (d DefaultClientService) GetClients(ctx context.Context, token string) ([]domain.Client, error) {
-	endpoint := fmt.Sprintf("%s/admin/realms/%s/clients", d.Configuration.GetBaseUrl(), d.Configuration.GetRealm())
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-
-	res, err := client.Do(req)
-
-	if err != nil {
-		log.WithError(err).Error("could not get realm clients")
-		return nil, err
-	}
-
-	if res.StatusCode != 200 {
-		return nil, errors.New("could not get clients -- see reason " + res.Status)
-	}
-
-	defer res.Body.Close()
-	data, err := io.ReadAll(res.Body)
-
+	var clients []domain.Client
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get realm clients",
+		method:   http.MethodGet,
+		endpoint: d.GetClientEndpoint(),
+		result:   &clients,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var clients []domain.Client
-	err = json.Unmarshal(data, &clients)
-
 	return clients, nil
 }
 
@@ -73,7 +47,7 @@ func (d DefaultClientService) GetClientById(ctx context.Context, clientId, token
 		}
 	}
 
-	return domain.Client{}, errors.New(fmt.Sprintf("could not find client: %s", clientId))
+	return domain.Client{}, fmt.Errorf("could not find client: %s: %w", clientId, ErrNotFound)
 }
 
 func (d DefaultClientService) GetClientByClientId(ctx context.Context, clientName, token string) (domain.Client, error) {
@@ -88,7 +62,7 @@ func (d DefaultClientService) GetClientByClientId(ctx context.Context, clientNam
 		}
 	}
 
-	return domain.Client{}, errors.New(fmt.Sprintf("could not find client: %s", clientName))
+	return domain.Client{}, fmt.Errorf("could not find client: %s: %w", clientName, ErrNotFound)
 }
 
 func (d DefaultClientService) GetClientsByIds(ctx context.Context, ids []string, token string) ([]domain.Client, error) {
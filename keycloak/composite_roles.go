@@ -0,0 +1,93 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"net/http"
+)
+
+func (d DefaultRoleService) GetCompositeRoles(ctx context.Context, clientId, name string, token string) ([]domain.Role, error) {
+	var roles []domain.Role
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get composite roles",
+		method:   http.MethodGet,
+		endpoint: d.compositesEndpoint(clientId, name),
+		result:   &roles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (d DefaultRoleService) AddCompositeRoles(ctx context.Context, clientId, name string, roles []domain.Role, token string) error {
+	return d.changeRoleMappings(ctx, "add composite roles", http.MethodPost, d.compositesEndpoint(clientId, name), roles, token)
+}
+
+func (d DefaultRoleService) RemoveCompositeRoles(ctx context.Context, clientId, name string, roles []domain.Role, token string) error {
+	return d.changeRoleMappings(ctx, "remove composite roles", http.MethodDelete, d.compositesEndpoint(clientId, name), roles, token)
+}
+
+func (d DefaultRoleService) GetUserRealmRoles(ctx context.Context, userId string, view RoleMappingView, token string) ([]domain.Role, error) {
+	return d.roleMappings(ctx, "get user realm roles", escapedPath(d.GetUserEndpoint(), userId, "role-mappings", "realm"), view, token)
+}
+
+// GetEffectiveUserRoles relies on Keycloak's composite role mappings, which
+// resolve composite roles and the roles of the user's groups.
+func (d DefaultRoleService) GetEffectiveUserRoles(ctx context.Context, userId string, clientIds []string, token string) (domain.EffectiveRoles, error) {
+	realmRoles, err := d.GetUserRealmRoles(ctx, userId, EffectiveRoles, token)
+	if err != nil {
+		return domain.EffectiveRoles{}, err
+	}
+
+	clients, err := d.ClientService.GetClients(ctx, token)
+	if err != nil {
+		return domain.EffectiveRoles{}, err
+	}
+
+	if len(clientIds) > 0 {
+		wanted := make(map[string]bool, len(clientIds))
+		for _, id := range clientIds {
+			wanted[id] = true
+		}
+
+		var filtered []domain.Client
+		for _, client := range clients {
+			if wanted[client.Id] {
+				filtered = append(filtered, client)
+			}
+		}
+		if len(filtered) < len(wanted) {
+			return domain.EffectiveRoles{}, fmt.Errorf("could not find every client: %w", ErrNotFound)
+		}
+		clients = filtered
+	}
+
+	clientRoles := make([][]domain.Role, len(clients))
+	err = forEach(ctx, len(clients), defaultBatchConcurrency, func(ctx context.Context, i int) error {
+		roles, err := d.GetUserClientRoles(ctx, userId, clients[i].Id, EffectiveRoles, token)
+		clientRoles[i] = roles
+		return err
+	})
+	if err != nil {
+		return domain.EffectiveRoles{}, err
+	}
+
+	effective := domain.EffectiveRoles{Realm: realmRoles, Client: map[string][]domain.Role{}}
+	for i, client := range clients {
+		if len(clientRoles[i]) > 0 {
+			effective.Client[client.ClientId] = clientRoles[i]
+		}
+	}
+
+	return effective, nil
+}
+
+func (d DefaultRoleService) compositesEndpoint(clientId, name string) string {
+	if clientId == "" {
+		return escapedPath(d.realmRoleEndpoint(name), "composites")
+	}
+	return escapedPath(d.clientRoleEndpoint(clientId, name), "composites")
+}
@@ -1,9 +1,9 @@
 package keycloak
 
 import (
-	"fmt"
 	"net/http"
 	"os"
+	"strings"
 )
 
 type ClientCredentials struct {
@@ -16,6 +16,9 @@ type Configuration interface {
 	GetRealm() string
 	GetClientCredentials() ClientCredentials
 	GetGroupEndpoint() string
+	GetRoleEndpoint() string
+	GetClientEndpoint() string
+	GetOpenIdConnectEndpoint() string
 	GetClient() *http.Client
 }
 
@@ -26,11 +29,11 @@ type DefaultKeycloakConfiguration struct {
 }
 
 func (d DefaultKeycloakConfiguration) GetUserEndpoint() string {
-	return fmt.Sprintf("%s/admin/realms/%s/users", d.BaseURL, d.Realm)
+	return escapedPath(d.GetBaseUrl(), "admin", "realms", d.Realm, "users")
 }
 
 func (d DefaultKeycloakConfiguration) GetBaseUrl() string {
-	return d.BaseURL
+	return strings.TrimSuffix(d.BaseURL, "/")
 }
 
 func (d DefaultKeycloakConfiguration) GetRealm() string {
@@ -45,7 +48,19 @@ func (d DefaultKeycloakConfiguration) GetClientCredentials() ClientCredentials {
 }
 
 func (d DefaultKeycloakConfiguration) GetGroupEndpoint() string {
-	return fmt.Sprintf("%s/admin/realms/%s/groups", d.BaseURL, d.Realm)
+	return escapedPath(d.GetBaseUrl(), "admin", "realms", d.Realm, "groups")
+}
+
+func (d DefaultKeycloakConfiguration) GetRoleEndpoint() string {
+	return escapedPath(d.GetBaseUrl(), "admin", "realms", d.Realm, "roles")
+}
+
+func (d DefaultKeycloakConfiguration) GetClientEndpoint() string {
+	return escapedPath(d.GetBaseUrl(), "admin", "realms", d.Realm, "clients")
+}
+
+func (d DefaultKeycloakConfiguration) GetOpenIdConnectEndpoint() string {
+	return escapedPath(d.GetBaseUrl(), "realms", d.Realm, "protocol", "openid-connect")
 }
 
 func (d DefaultKeycloakConfiguration) GetClient() *http.Client {
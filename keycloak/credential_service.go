@@ -2,58 +2,210 @@ package keycloak
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/hub1989/keycloak-grpc-service/domain"
+	"github.com/hub1989/keycloak-grpc-service/metrics"
 	log "github.com/sirupsen/logrus"
-	"io"
 	"net/http"
-	"strings"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRefreshBefore = 30 * time.Second
+	defaultTokenTimeout  = 30 * time.Second
 )
 
 type CredentialService interface {
 	ObtainTokenForOps(ctx context.Context) (domain.AccessTokenResponse, error)
 }
 
+// DefaultCredentialService keeps the service-account token in memory and
+// refreshes it shortly before it expires. Concurrent callers that find no
+// usable token share a single request to the token endpoint.
 type DefaultCredentialService struct {
 	Configuration
+	// RefreshBefore is how long before expiry the token is refreshed in the background.
+	RefreshBefore time.Duration
+
+	mu               sync.Mutex
+	token            domain.AccessTokenResponse
+	expiresAt        time.Time
+	refreshExpiresAt time.Time
+	inflight         *tokenCall
+	timer            *time.Timer
+	closed           bool
 }
 
-func (d DefaultCredentialService) ObtainTokenForOps(ctx context.Context) (domain.AccessTokenResponse, error) {
-	endpoint := fmt.Sprintf("%s/realms/%s/protocol/openid-connect/token", d.GetBaseUrl(), d.GetRealm())
+type tokenCall struct {
+	done  chan struct{}
+	token domain.AccessTokenResponse
+	err   error
+}
 
-	credentials := d.Configuration.GetClientCredentials()
+func NewDefaultCredentialService(configuration Configuration) *DefaultCredentialService {
+	return &DefaultCredentialService{
+		Configuration: configuration,
+		RefreshBefore: defaultRefreshBefore,
+	}
+}
 
-	requestForm := fmt.Sprintf("client_id=%s&client_secret=%s&grant_type=client_credentials", credentials.ClientId, credentials.ClientSecret)
-	payload := strings.NewReader(requestForm)
+func (d *DefaultCredentialService) ObtainTokenForOps(ctx context.Context) (domain.AccessTokenResponse, error) {
+	d.mu.Lock()
+	now := time.Now()
+	if d.token.AccessToken != "" && now.Before(d.expiresAt) {
+		metrics.CacheLookup("service_account_token", true)
+		token := d.token
+		if now.After(d.expiresAt.Add(-d.refreshBefore(d.token.ExpiresIn))) {
+			d.startRefreshLocked()
+		}
+		d.mu.Unlock()
+		return token, nil
+	}
 
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, payload)
+	metrics.CacheLookup("service_account_token", false)
+	call := d.startRefreshLocked()
+	d.mu.Unlock()
 
-	if err != nil {
-		log.WithError(err).Error("could not get access token for operation")
-		return domain.AccessTokenResponse{}, err
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return domain.AccessTokenResponse{}, ctx.Err()
 	}
-	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+}
 
-	res, err := client.Do(req)
-	if err != nil {
-		log.WithError(err).Error("could not get access token for operation")
-		return domain.AccessTokenResponse{}, err
+// Close stops the background refresh timer.
+func (d *DefaultCredentialService) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.closed = true
+	if d.timer != nil {
+		d.timer.Stop()
 	}
-	defer res.Body.Close()
+}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		log.WithError(err).Error("could not get access token for operation")
-		return domain.AccessTokenResponse{}, err
+// startRefreshLocked returns the refresh in flight, starting one if needed. d.mu must be held.
+func (d *DefaultCredentialService) startRefreshLocked() *tokenCall {
+	if d.inflight != nil {
+		return d.inflight
+	}
+
+	call := &tokenCall{done: make(chan struct{})}
+	d.inflight = call
+
+	refreshToken := ""
+	if d.token.RefreshToken != "" && time.Now().Before(d.refreshExpiresAt) {
+		refreshToken = d.token.RefreshToken
+	}
+
+	go d.refresh(call, refreshToken)
+	return call
+}
+
+func (d *DefaultCredentialService) refresh(call *tokenCall, refreshToken string) {
+	// the request is shared by every waiting caller, so it must not be bound to any one caller's context
+	ctx, cancel := context.WithTimeout(context.Background(), d.tokenTimeout())
+	defer cancel()
+
+	issuedAt := time.Now()
+	token, err := d.requestToken(ctx, refreshToken)
+	if err != nil && refreshToken != "" {
+		log.WithError(err).Warn("could not refresh access token, requesting a new one")
+		token, err = d.requestToken(ctx, "")
 	}
 
+	d.mu.Lock()
+	if err == nil {
+		d.token = token
+		d.expiresAt = issuedAt.Add(time.Duration(token.ExpiresIn) * time.Second)
+		d.refreshExpiresAt = issuedAt.Add(time.Duration(token.RefreshExpiresIn) * time.Second)
+		d.scheduleRefreshLocked()
+	}
+	d.inflight = nil
+	d.mu.Unlock()
+
+	call.token, call.err = token, err
+	close(call.done)
+}
+
+func (d *DefaultCredentialService) scheduleRefreshLocked() {
+	if d.closed {
+		return
+	}
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+
+	wait := time.Until(d.expiresAt.Add(-d.refreshBefore(d.token.ExpiresIn)))
+	if wait < 0 {
+		wait = 0
+	}
+
+	d.timer = time.AfterFunc(wait, func() {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		if !d.closed {
+			d.startRefreshLocked()
+		}
+	})
+}
+
+// refreshBefore never exceeds half of the token lifetime, so short-lived tokens are still reused.
+func (d *DefaultCredentialService) refreshBefore(expiresIn int) time.Duration {
+	before := d.RefreshBefore
+	if before <= 0 {
+		before = defaultRefreshBefore
+	}
+
+	if half := time.Duration(expiresIn) * time.Second / 2; before > half {
+		return half
+	}
+	return before
+}
+
+func (d *DefaultCredentialService) tokenTimeout() time.Duration {
+	if client := d.GetClient(); client != nil && client.Timeout > 0 {
+		return client.Timeout
+	}
+	return defaultTokenTimeout
+}
+
+func (d *DefaultCredentialService) requestToken(ctx context.Context, refreshToken string) (_ domain.AccessTokenResponse, err error) {
+	endpoint := escapedPath(d.GetOpenIdConnectEndpoint(), "token")
+
+	credentials := d.Configuration.GetClientCredentials()
+
+	form := url.Values{}
+	form.Set("client_id", credentials.ClientId)
+	form.Set("client_secret", credentials.ClientSecret)
+	if refreshToken != "" {
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", refreshToken)
+	} else {
+		form.Set("grant_type", "client_credentials")
+	}
+
+	defer func() { metrics.TokenRefreshed(form.Get("grant_type"), err) }()
+
 	var accessToken domain.AccessTokenResponse
-	err = json.Unmarshal(body, &accessToken)
+	err = newAdminClient(d.Configuration).do(ctx, "", adminRequest{
+		op:             "get access token for operation",
+		method:         http.MethodPost,
+		endpoint:       endpoint,
+		form:           form,
+		result:         &accessToken,
+		serviceAccount: true,
+	})
 	if err != nil {
+		if !errors.Is(err, ErrServiceAccount) {
+			err = fmt.Errorf("%w: %w", ErrServiceAccount, err)
+		}
 		return domain.AccessTokenResponse{}, err
 	}
 
-	return accessToken, err
+	return accessToken, nil
 }
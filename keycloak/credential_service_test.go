@@ -0,0 +1,150 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// tokenServer counts token requests and answers them with numbered tokens.
+type tokenServer struct {
+	*httptest.Server
+	requests   atomic.Int32
+	grantTypes chan string
+	release    chan struct{}
+	expiresIn  int
+}
+
+func newTokenServer(t *testing.T, expiresIn int, blocking bool) *tokenServer {
+	t.Helper()
+
+	s := &tokenServer{grantTypes: make(chan string, 100), expiresIn: expiresIn}
+	if blocking {
+		s.release = make(chan struct{})
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.release != nil {
+			<-s.release
+		}
+		n := s.requests.Add(1)
+		_ = r.ParseForm()
+		s.grantTypes <- r.Form.Get("grant_type")
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":%d,"refresh_token":"refresh-%d","refresh_expires_in":%d}`,
+			n, s.expiresIn, n, s.expiresIn*10)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *tokenServer) credentialService() *DefaultCredentialService {
+	return NewDefaultCredentialService(DefaultKeycloakConfiguration{
+		BaseURL: s.URL,
+		Realm:   "test",
+		Client:  s.Client(),
+	})
+}
+
+func TestObtainTokenForOpsSharesOneFetch(t *testing.T) {
+	server := newTokenServer(t, 300, true)
+	service := server.credentialService()
+	defer service.Close()
+
+	const callers = 20
+	tokens := make([]string, callers)
+	errs := make([]error, callers)
+
+	var started, done sync.WaitGroup
+	started.Add(callers)
+	done.Add(callers)
+	for i := 0; i < callers; i++ {
+		go func(i int) {
+			defer done.Done()
+			started.Done()
+			token, err := service.ObtainTokenForOps(context.Background())
+			tokens[i], errs[i] = token.AccessToken, err
+		}(i)
+	}
+
+	started.Wait()
+	// give every caller time to find the refresh in flight
+	time.Sleep(50 * time.Millisecond)
+	close(server.release)
+	done.Wait()
+
+	if got := server.requests.Load(); got != 1 {
+		t.Fatalf("token requests = %d, want 1", got)
+	}
+	for i := range tokens {
+		if errs[i] != nil {
+			t.Fatalf("caller %d: %v", i, errs[i])
+		}
+		if tokens[i] != "token-1" {
+			t.Errorf("caller %d got %q, want token-1", i, tokens[i])
+		}
+	}
+}
+
+func TestObtainTokenForOpsRefreshesBeforeExpiry(t *testing.T) {
+	// with a 2s lifetime the refresh is scheduled after half of it
+	server := newTokenServer(t, 2, false)
+	service := server.credentialService()
+	defer service.Close()
+
+	token, err := service.ObtainTokenForOps(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "token-1" {
+		t.Fatalf("got %q, want token-1", token.AccessToken)
+	}
+	if grant := <-server.grantTypes; grant != "client_credentials" {
+		t.Errorf("first grant = %q, want client_credentials", grant)
+	}
+
+	select {
+	case grant := <-server.grantTypes:
+		if grant != "refresh_token" {
+			t.Errorf("background grant = %q, want refresh_token", grant)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("token was not refreshed before it expired")
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		token, err = service.ObtainTokenForOps(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token.AccessToken == "token-2" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %q after the refresh, want token-2", token.AccessToken)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestCloseStopsRefreshTimer(t *testing.T) {
+	server := newTokenServer(t, 2, false)
+	service := server.credentialService()
+
+	if _, err := service.ObtainTokenForOps(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	service.Close()
+
+	time.Sleep(1500 * time.Millisecond)
+	if got := server.requests.Load(); got != 1 {
+		t.Fatalf("token requests = %d after Close, want 1", got)
+	}
+}
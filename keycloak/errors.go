@@ -0,0 +1,103 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrConflict         = errors.New("conflict")
+	ErrForbidden        = errors.New("forbidden")
+	ErrUnauthorized     = errors.New("unauthorized")
+	ErrValidationFailed = errors.New("validation failed")
+	ErrUnavailable      = errors.New("unavailable")
+	// ErrServiceAccount marks failures caused by the service's own credentials,
+	// which the caller can do nothing about.
+	ErrServiceAccount = errors.New("service account rejected")
+)
+
+// Error is returned when Keycloak answers with a non-2xx status. It matches
+// one of the sentinel errors above with errors.Is, depending on the status.
+type Error struct {
+	// Op describes the failed operation, e.g. "create user".
+	Op         string
+	StatusCode int
+	// Code is the OAuth error code ("error") when Keycloak sends one.
+	Code    string
+	Message string
+	// Field and Params are set by Keycloak for user profile validation failures.
+	Field  string
+	Params []string
+}
+
+// errorBody covers the error shapes of both the admin API and the OIDC endpoints.
+type errorBody struct {
+	Error            string        `json:"error"`
+	ErrorDescription string        `json:"error_description"`
+	ErrorMessage     string        `json:"errorMessage"`
+	Field            string        `json:"field"`
+	Params           []interface{} `json:"params"`
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("could not %s. got status: %v", e.Op, e.StatusCode)
+	if e.Message != "" {
+		msg = fmt.Sprintf("%s, reason: %s", msg, e.Message)
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return errorKind(e.StatusCode)
+}
+
+func newResponseError(op string, res *http.Response, body []byte) error {
+	e := &Error{
+		Op:         op,
+		StatusCode: res.StatusCode,
+	}
+
+	var parsed errorBody
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		e.Code = parsed.Error
+		e.Field = parsed.Field
+		for _, p := range parsed.Params {
+			e.Params = append(e.Params, fmt.Sprint(p))
+		}
+
+		switch {
+		case parsed.ErrorMessage != "":
+			e.Message = parsed.ErrorMessage
+		case parsed.ErrorDescription != "":
+			e.Message = parsed.ErrorDescription
+		default:
+			e.Message = parsed.Error
+		}
+	} else if text := strings.TrimSpace(string(body)); text != "" && len(text) < 512 {
+		e.Message = text
+	}
+
+	return e
+}
+
+func errorKind(statusCode int) error {
+	switch {
+	case statusCode == http.StatusBadRequest:
+		return ErrValidationFailed
+	case statusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case statusCode == http.StatusForbidden:
+		return ErrForbidden
+	case statusCode == http.StatusNotFound:
+		return ErrNotFound
+	case statusCode == http.StatusConflict:
+		return ErrConflict
+	case statusCode >= http.StatusInternalServerError:
+		return ErrUnavailable
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"github.com/hub1989/keycloak-grpc-service/metrics"
+	"io"
+	"net/http"
+)
+
+type HealthService interface {
+	CheckTokenEndpoint(ctx context.Context) error
+}
+
+type DefaultHealthService struct {
+	Configuration
+}
+
+// CheckTokenEndpoint reports whether the realm's token endpoint answers. It
+// sends a GET, which Keycloak rejects without issuing a token or recording a
+// failed login event, so any answer below 500 means the endpoint is up.
+func (d DefaultHealthService) CheckTokenEndpoint(ctx context.Context) error {
+	endpoint := escapedPath(d.GetOpenIdConnectEndpoint(), "token")
+
+	req, err := http.NewRequestWithContext(metrics.WithOperation(ctx, "check token endpoint"), http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := d.GetClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("token endpoint is unreachable: %w", err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("token endpoint is unavailable. got status: %v: %w", res.StatusCode, ErrUnavailable)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package keycloak
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/hub1989/keycloak-grpc-service/metrics"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultKeysMaxAge  = 15 * time.Minute
+	minKeysRefreshWait = 10 * time.Second
+	maxKeysRefreshWait = 5 * time.Minute
+	keysFetchTimeout   = 30 * time.Second
+)
+
+// realmKeys caches the realm's signing keys published at the JWKS endpoint.
+// Keys are refetched once they are older than maxAge, or when a token names
+// a key id that is not cached, which is how Keycloak key rotation shows up.
+// Concurrent lookups share one fetch, and fetches are spaced out by a wait
+// that doubles with each failure, so an unreachable JWKS endpoint or tokens
+// with made-up key ids cannot turn every call into a request to Keycloak.
+type realmKeys struct {
+	Configuration
+	maxAge time.Duration
+
+	fetches singleflight.Group
+
+	mu          sync.Mutex
+	keys        map[string]interface{}
+	fetchedAt   time.Time
+	attemptedAt time.Time
+	failures    int
+	lastErr     error
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func newRealmKeys(configuration Configuration) *realmKeys {
+	return &realmKeys{Configuration: configuration, maxAge: defaultKeysMaxAge}
+}
+
+func (r *realmKeys) key(ctx context.Context, kid string) (interface{}, error) {
+	r.mu.Lock()
+	key, ok := r.keys[kid]
+	stale := time.Since(r.fetchedAt) > r.maxAge
+	metrics.CacheLookup("realm_keys", ok && !stale)
+	if ok && !stale {
+		r.mu.Unlock()
+		return key, nil
+	}
+	canFetch := time.Since(r.attemptedAt) > r.refreshWaitLocked()
+	lastErr := r.lastErr
+	r.mu.Unlock()
+
+	if !canFetch {
+		if ok {
+			return key, nil
+		}
+		if lastErr != nil {
+			return nil, lastErr
+		}
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	keys, err := r.fetch(ctx)
+	if err != nil {
+		// keep verifying with the keys we have while the JWKS endpoint is unreachable
+		if ok {
+			log.WithError(err).Warn("could not refresh realm keys")
+			return key, nil
+		}
+		return nil, err
+	}
+
+	if key, ok = keys[kid]; !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// refreshWaitLocked is the minimum time between two fetches. r.mu must be held.
+func (r *realmKeys) refreshWaitLocked() time.Duration {
+	wait := minKeysRefreshWait
+	for i := 0; i < r.failures && wait < maxKeysRefreshWait; i++ {
+		wait *= 2
+	}
+	if wait > maxKeysRefreshWait {
+		wait = maxKeysRefreshWait
+	}
+	return wait
+}
+
+// fetch returns the keys of a fetch shared by every concurrent caller.
+func (r *realmKeys) fetch(ctx context.Context) (map[string]interface{}, error) {
+	result := r.fetches.DoChan("keys", func() (interface{}, error) {
+		// a caller that gives up must not cancel the fetch the others are waiting on
+		ctx, cancel := context.WithTimeout(context.Background(), keysFetchTimeout)
+		defer cancel()
+
+		keys, err := r.requestKeys(ctx)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.attemptedAt = time.Now()
+		if err != nil {
+			r.failures++
+			r.lastErr = err
+			return nil, err
+		}
+		r.keys, r.fetchedAt = keys, r.attemptedAt
+		r.failures, r.lastErr = 0, nil
+		return keys, nil
+	})
+
+	select {
+	case res := <-result:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(map[string]interface{}), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *realmKeys) requestKeys(ctx context.Context) (map[string]interface{}, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	err := newAdminClient(r.Configuration).do(ctx, "", adminRequest{
+		op:       "get realm keys",
+		method:   http.MethodGet,
+		endpoint: escapedPath(r.GetOpenIdConnectEndpoint(), "certs"),
+		result:   &set,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]interface{})
+	for _, jwk := range set.Keys {
+		if jwk.Use == "enc" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			log.WithError(err).WithField("kid", jwk.Kid).Warn("skipping realm key")
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, errors.New("unsupported key type " + k.Kty)
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
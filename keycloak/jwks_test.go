@@ -0,0 +1,76 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newKeysServer(t *testing.T, release chan struct{}) (*realmKeys, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if release != nil {
+			<-release
+		}
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	return newRealmKeys(DefaultKeycloakConfiguration{BaseURL: server.URL, Realm: "test", Client: server.Client()}), &requests
+}
+
+func TestRealmKeysShareOneFetch(t *testing.T) {
+	release := make(chan struct{})
+	keys, requests := newKeysServer(t, release)
+
+	const callers = 20
+	var started, done sync.WaitGroup
+	started.Add(callers)
+	done.Add(callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			defer done.Done()
+			started.Done()
+			_, _ = keys.key(context.Background(), "kid")
+		}()
+	}
+
+	started.Wait()
+	// give every caller time to join the fetch in flight
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	done.Wait()
+
+	if got := requests.Load(); got != 1 {
+		t.Fatalf("JWKS requests = %d, want 1", got)
+	}
+}
+
+func TestRealmKeysBackOffAfterFailure(t *testing.T) {
+	keys, requests := newKeysServer(t, nil)
+
+	for i := 0; i < 5; i++ {
+		_, err := keys.key(context.Background(), "kid")
+		if !errors.Is(err, ErrUnavailable) {
+			t.Fatalf("lookup %d: error = %v, want ErrUnavailable", i, err)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Fatalf("JWKS requests = %d, want 1", got)
+	}
+
+	keys.mu.Lock()
+	wait := keys.refreshWaitLocked()
+	keys.mu.Unlock()
+	if wait != 2*minKeysRefreshWait {
+		t.Errorf("wait after one failure = %v, want %v", wait, 2*minKeysRefreshWait)
+	}
+}
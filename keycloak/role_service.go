@@ -1,15 +1,11 @@
 package keycloak
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"errors"
-	"fmt"
 	"github.com/hub1989/keycloak-grpc-service/domain"
-	log "github.com/sirupsen/logrus"
-	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 type RoleService interface {
@@ -17,179 +13,235 @@ type RoleService interface {
 	GetUserRoles(ctx context.Context, userId string, token string) ([]domain.Role, error)
 	GetAvailableRoles(ctx context.Context, userId string, token string) ([]domain.Role, error)
 	RemoveRoleFromUser(ctx context.Context, userId string, role []domain.Role, token string) error
+	// CreateRole creates a client role on the service's own client, see CreateRealmRole for realm roles.
 	CreateRole(ctx context.Context, role domain.Role, token string) error
+
+	GetRealmRoles(ctx context.Context, search domain.RoleSearch, token string) ([]domain.Role, error)
+	GetRealmRole(ctx context.Context, name string, token string) (domain.Role, error)
+	CreateRealmRole(ctx context.Context, role domain.Role, token string) error
+	UpdateRealmRole(ctx context.Context, name string, role domain.Role, token string) error
+	DeleteRealmRole(ctx context.Context, name string, token string) error
+	GetRealmRoleUsers(ctx context.Context, name string, first, max int, token string) ([]domain.UserRepresentation, error)
+	GetRealmRoleGroups(ctx context.Context, name string, first, max int, token string) ([]domain.GroupOverview, error)
+
+	// The client role methods take the client's internal id, not its clientId.
+	GetClientRoles(ctx context.Context, clientId string, search domain.RoleSearch, token string) ([]domain.Role, error)
+	GetClientRole(ctx context.Context, clientId, name string, token string) (domain.Role, error)
+	CreateClientRole(ctx context.Context, clientId string, role domain.Role, token string) error
+	UpdateClientRole(ctx context.Context, clientId, name string, role domain.Role, token string) error
+	DeleteClientRole(ctx context.Context, clientId, name string, token string) error
+	GetClientRoleUsers(ctx context.Context, clientId, name string, first, max int, token string) ([]domain.UserRepresentation, error)
+	GetClientRoleGroups(ctx context.Context, clientId, name string, first, max int, token string) ([]domain.GroupOverview, error)
+
+	AssignClientRolesToUser(ctx context.Context, userId, clientId string, roles []domain.Role, token string) error
+	RemoveClientRolesFromUser(ctx context.Context, userId, clientId string, roles []domain.Role, token string) error
+	GetUserClientRoles(ctx context.Context, userId, clientId string, view RoleMappingView, token string) ([]domain.Role, error)
+	AssignClientRolesToGroup(ctx context.Context, groupId, clientId string, roles []domain.Role, token string) error
+	RemoveClientRolesFromGroup(ctx context.Context, groupId, clientId string, roles []domain.Role, token string) error
+	GetGroupClientRoles(ctx context.Context, groupId, clientId string, view RoleMappingView, token string) ([]domain.Role, error)
+
+	// The composite role methods address a realm role when clientId is empty.
+	GetCompositeRoles(ctx context.Context, clientId, name string, token string) ([]domain.Role, error)
+	AddCompositeRoles(ctx context.Context, clientId, name string, roles []domain.Role, token string) error
+	RemoveCompositeRoles(ctx context.Context, clientId, name string, roles []domain.Role, token string) error
+
+	GetUserRealmRoles(ctx context.Context, userId string, view RoleMappingView, token string) ([]domain.Role, error)
+	// GetEffectiveUserRoles limits the client roles to the given internal client ids, or reads those of every client when there are none.
+	GetEffectiveUserRoles(ctx context.Context, userId string, clientIds []string, token string) (domain.EffectiveRoles, error)
 }
 
+// RoleMappingView selects which roles of a user or group are listed.
+type RoleMappingView string
+
+const (
+	// AssignedRoles are the roles mapped directly.
+	AssignedRoles RoleMappingView = ""
+	// AvailableRoles are the roles that are not mapped yet.
+	AvailableRoles RoleMappingView = "available"
+	// EffectiveRoles include the roles inherited through composite roles and groups.
+	EffectiveRoles RoleMappingView = "composite"
+)
+
 type DefaultRoleService struct {
 	Configuration
 	ClientService
 }
 
 func (d DefaultRoleService) AssignRoleToUser(ctx context.Context, userId string, role []domain.Role, token string) error {
-	endpoint := fmt.Sprintf("%s/%s/role-mappings/realm", d.GetUserEndpoint(), userId)
-
-	body, err := json.Marshal(role)
-	bodyReader := bytes.NewReader(body)
-
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bodyReader)
-
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := client.Do(req)
-
-	if err != nil {
-		log.WithError(err).Error("could not assign role user")
-	}
-
-	if resp.StatusCode != 204 {
-		return errors.New(fmt.Sprintf("could not assign role to user, see reason: %v", resp.Status))
-	}
-
-	return nil
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "assign role to user",
+		method:   http.MethodPost,
+		endpoint: escapedPath(d.GetUserEndpoint(), userId, "role-mappings", "realm"),
+		body:     role,
+	})
+	return err
 }
 
 func (d DefaultRoleService) GetUserRoles(ctx context.Context, userId string, token string) ([]domain.Role, error) {
-	endpoint := fmt.Sprintf("%s/%s/role-mappings/realm", d.GetUserEndpoint(), userId)
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-
-	res, err := client.Do(req)
-
-	if err != nil {
-		log.WithError(err).Error("could not available user roles")
-		return nil, err
-	}
-
-	if res.StatusCode != 200 {
-		return nil, errors.New("could not get user roles -- see reason " + res.Status)
-	}
-
-	defer res.Body.Close()
-	data, err := io.ReadAll(res.Body)
-
+	var roles []domain.Role
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get user roles",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.GetUserEndpoint(), userId, "role-mappings", "realm"),
+		result:   &roles,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var roles []domain.Role
-	err = json.Unmarshal(data, &roles)
-
 	return roles, nil
 }
 
 func (d DefaultRoleService) GetAvailableRoles(ctx context.Context, userId string, token string) ([]domain.Role, error) {
-	endpoint := fmt.Sprintf("%s/%s/role-mappings/realm/available", d.GetUserEndpoint(), userId)
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-
+	var roles []domain.Role
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get available user roles",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.GetUserEndpoint(), userId, "role-mappings", "realm", "available"),
+		result:   &roles,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
+	return roles, nil
+}
 
-	res, err := client.Do(req)
+func (d DefaultRoleService) RemoveRoleFromUser(ctx context.Context, userId string, role []domain.Role, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "remove role from user",
+		method:   http.MethodDelete,
+		endpoint: escapedPath(d.GetUserEndpoint(), userId, "role-mappings", "realm"),
+		body:     role,
+	})
+	return err
+}
 
+func (d DefaultRoleService) CreateRole(ctx context.Context, role domain.Role, token string) error {
+	clientName := d.Configuration.GetClientCredentials().ClientId
+	client, err := d.ClientService.GetClientByClientId(ctx, clientName, token)
 	if err != nil {
-		log.WithError(err).Error("could not available user roles")
-		return nil, err
+		return err
 	}
 
-	if res.StatusCode != 200 {
-		return nil, errors.New("could not get user info -- see reason " + res.Status)
-	}
+	err = newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "create role",
+		method:   http.MethodPost,
+		endpoint: escapedPath(d.GetClientEndpoint(), client.Id, "roles"),
+		body:     role,
+	})
+	return err
+}
 
-	defer res.Body.Close()
-	data, err := io.ReadAll(res.Body)
+func (d DefaultRoleService) GetRealmRoles(ctx context.Context, search domain.RoleSearch, token string) ([]domain.Role, error) {
+	query := pageQuery(search.First, search.Max)
+	query.Set("briefRepresentation", strconv.FormatBool(search.Brief))
+	if search.Search != "" {
+		query.Set("search", search.Search)
+	}
 
+	var roles []domain.Role
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get realm roles",
+		method:   http.MethodGet,
+		endpoint: d.GetRoleEndpoint(),
+		query:    query,
+		result:   &roles,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var roles []domain.Role
-	err = json.Unmarshal(data, &roles)
-
 	return roles, nil
 }
 
-func (d DefaultRoleService) RemoveRoleFromUser(ctx context.Context, userId string, role []domain.Role, token string) error {
-	endpoint := fmt.Sprintf("%s/%s/role-mappings/realm", d.GetUserEndpoint(), userId)
-
-	body, err := json.Marshal(role)
-	bodyReader := bytes.NewReader(body)
-
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, endpoint, bodyReader)
-
+func (d DefaultRoleService) GetRealmRole(ctx context.Context, name string, token string) (domain.Role, error) {
+	var role domain.Role
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get realm role",
+		method:   http.MethodGet,
+		endpoint: d.realmRoleEndpoint(name),
+		result:   &role,
+	})
 	if err != nil {
-		return err
+		return domain.Role{}, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-
-	res, err := client.Do(req)
+	return role, nil
+}
 
-	if err != nil {
-		log.WithError(err).Error("could not delete user")
-	}
+func (d DefaultRoleService) CreateRealmRole(ctx context.Context, role domain.Role, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "create realm role",
+		method:   http.MethodPost,
+		endpoint: d.GetRoleEndpoint(),
+		body:     role,
+	})
+	return err
+}
 
-	if res.StatusCode != 204 {
-		return errors.New("could not get user info -- see reason " + res.Status)
-	}
+// UpdateRealmRole replaces the role's representation. Keycloak renames the role when role.Name differs from name.
+func (d DefaultRoleService) UpdateRealmRole(ctx context.Context, name string, role domain.Role, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "update realm role",
+		method:   http.MethodPut,
+		endpoint: d.realmRoleEndpoint(name),
+		body:     role,
+	})
+	return err
+}
 
-	return nil
+func (d DefaultRoleService) DeleteRealmRole(ctx context.Context, name string, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "delete realm role",
+		method:   http.MethodDelete,
+		endpoint: d.realmRoleEndpoint(name),
+	})
+	return err
 }
 
-func (d DefaultRoleService) CreateRole(ctx context.Context, role domain.Role, token string) error {
-	clientName := d.Configuration.GetClientCredentials().ClientId
-	client, err := d.ClientService.GetClientByClientId(ctx, clientName, token)
+func (d DefaultRoleService) GetRealmRoleUsers(ctx context.Context, name string, first, max int, token string) ([]domain.UserRepresentation, error) {
+	var users []domain.UserRepresentation
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get realm role users",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.realmRoleEndpoint(name), "users"),
+		query:    pageQuery(first, max),
+		result:   &users,
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	endpoint := fmt.Sprintf("%s/admin/realms/%s/clients/%s/roles", d.Configuration.GetBaseUrl(), d.Configuration.GetRealm(), client.Id)
-	body, err := json.Marshal(role)
-	bodyReader := bytes.NewReader(body)
-
-	httpClient := &http.Client{}
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bodyReader)
+	return users, nil
+}
 
+func (d DefaultRoleService) GetRealmRoleGroups(ctx context.Context, name string, first, max int, token string) ([]domain.GroupOverview, error) {
+	var groups []domain.GroupOverview
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get realm role groups",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.realmRoleEndpoint(name), "groups"),
+		query:    pageQuery(first, max),
+		result:   &groups,
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := httpClient.Do(req)
+	return groups, nil
+}
 
-	if err != nil {
-		log.WithError(err).Error("could not assign role user")
-	}
+// role names may contain characters such as spaces or slashes
+func (d DefaultRoleService) realmRoleEndpoint(name string) string {
+	return escapedPath(d.GetRoleEndpoint(), name)
+}
 
-	if resp.StatusCode != 201 {
-		return errors.New(fmt.Sprintf("could not create role, see reason: %v", resp.Status))
+func pageQuery(first, max int) url.Values {
+	query := url.Values{}
+	query.Set("first", strconv.Itoa(first))
+	if max > 0 {
+		query.Set("max", strconv.Itoa(max))
 	}
-
-	return nil
+	return query
 }
@@ -0,0 +1,147 @@
+package keycloak
+
+import (
+	"context"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"net/http"
+	"net/url"
+)
+
+type SessionService interface {
+	GetUserSessions(ctx context.Context, userId, token string) ([]domain.UserSession, error)
+	// GetUserOfflineSessions lists the offline sessions of the user with the client, by its internal id.
+	GetUserOfflineSessions(ctx context.Context, userId, clientId, token string) ([]domain.UserSession, error)
+	// GetAllUserOfflineSessions lists the offline sessions with every client the user holds an offline token for.
+	GetAllUserOfflineSessions(ctx context.Context, userId, token string) ([]domain.UserSession, error)
+	GetUserConsents(ctx context.Context, userId, token string) ([]domain.UserConsent, error)
+	DeleteSession(ctx context.Context, sessionId string, offline bool, token string) error
+	// LogoutUser ends every active session of the user.
+	LogoutUser(ctx context.Context, userId, token string) error
+	// RevokeUserConsent revokes the consent and offline tokens of the user for the client, by its clientId.
+	RevokeUserConsent(ctx context.Context, userId, clientId, token string) error
+}
+
+type DefaultSessionService struct {
+	Configuration
+	// Concurrency limits the offline session lookups run in parallel.
+	Concurrency int
+}
+
+func (d DefaultSessionService) GetUserSessions(ctx context.Context, userId, token string) ([]domain.UserSession, error) {
+	var sessions []domain.UserSession
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get user sessions",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.GetUserEndpoint(), userId, "sessions"),
+		result:   &sessions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (d DefaultSessionService) GetUserOfflineSessions(ctx context.Context, userId, clientId, token string) ([]domain.UserSession, error) {
+	var sessions []domain.UserSession
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get user offline sessions",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.GetUserEndpoint(), userId, "offline-sessions", clientId),
+		result:   &sessions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sessions {
+		sessions[i].Offline = true
+	}
+	return sessions, nil
+}
+
+func (d DefaultSessionService) GetAllUserOfflineSessions(ctx context.Context, userId, token string) ([]domain.UserSession, error) {
+	consents, err := d.GetUserConsents(ctx, userId, token)
+	if err != nil {
+		return nil, err
+	}
+
+	var clientIds []string
+	for _, consent := range consents {
+		if clientId, ok := consent.OfflineClient(); ok {
+			clientIds = append(clientIds, clientId)
+		}
+	}
+
+	results := make([][]domain.UserSession, len(clientIds))
+	err = forEach(ctx, len(clientIds), d.Concurrency, func(ctx context.Context, i int) error {
+		sessions, err := d.GetUserOfflineSessions(ctx, userId, clientIds[i], token)
+		results[i] = sessions
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// one offline session spans every client it was used with, so the same
+	// session can be listed for several clients
+	seen := make(map[string]bool)
+	var sessions []domain.UserSession
+	for _, clientSessions := range results {
+		for _, session := range clientSessions {
+			if !seen[session.Id] {
+				seen[session.Id] = true
+				sessions = append(sessions, session)
+			}
+		}
+	}
+	return sessions, nil
+}
+
+func (d DefaultSessionService) GetUserConsents(ctx context.Context, userId, token string) ([]domain.UserConsent, error) {
+	var consents []domain.UserConsent
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get user consents",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.GetUserEndpoint(), userId, "consents"),
+		result:   &consents,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return consents, nil
+}
+
+func (d DefaultSessionService) DeleteSession(ctx context.Context, sessionId string, offline bool, token string) error {
+	query := url.Values{}
+	if offline {
+		query.Set("isOffline", "true")
+	}
+
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "delete session",
+		method:   http.MethodDelete,
+		endpoint: escapedPath(d.GetBaseUrl(), "admin", "realms", d.GetRealm(), "sessions", sessionId),
+		query:    query,
+	})
+	return err
+}
+
+func (d DefaultSessionService) LogoutUser(ctx context.Context, userId, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "logout user",
+		method:   http.MethodPost,
+		endpoint: escapedPath(d.GetUserEndpoint(), userId, "logout"),
+	})
+	return err
+}
+
+func (d DefaultSessionService) RevokeUserConsent(ctx context.Context, userId, clientId, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "revoke user consent",
+		method:   http.MethodDelete,
+		endpoint: escapedPath(d.GetUserEndpoint(), userId, "consents", clientId),
+	})
+	return err
+}
@@ -0,0 +1,131 @@
+package keycloak
+
+import (
+	"context"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"net/http"
+	"net/url"
+)
+
+// TokenService works with end-user tokens through the realm's OIDC
+// endpoints, authenticating as the client the tokens were issued to.
+type TokenService interface {
+	RefreshToken(ctx context.Context, refreshToken, scope string, client ClientCredentials) (domain.AccessTokenResponse, error)
+	// Logout ends the session the refresh token belongs to.
+	Logout(ctx context.Context, refreshToken string, client ClientCredentials) error
+	// RevokeToken revokes an access or refresh token, tokenTypeHint may be empty.
+	RevokeToken(ctx context.Context, token, tokenTypeHint string, client ClientCredentials) error
+	// IntrospectToken asks Keycloak whether the token is active, as in RFC 7662,
+	// authenticating as this service's client. Claims are only set for active tokens.
+	IntrospectToken(ctx context.Context, token string) (domain.TokenClaims, bool, error)
+	// GetUserInfo reads the claims of the access token's user from the userinfo endpoint.
+	GetUserInfo(ctx context.Context, accessToken string) (domain.OIDCInfo, error)
+}
+
+type DefaultTokenService struct {
+	Configuration
+}
+
+func (d DefaultTokenService) RefreshToken(ctx context.Context, refreshToken, scope string, client ClientCredentials) (domain.AccessTokenResponse, error) {
+	form := clientForm(client)
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+	if scope != "" {
+		form.Set("scope", scope)
+	}
+
+	var accessToken domain.AccessTokenResponse
+	err := newAdminClient(d.Configuration).do(ctx, "", adminRequest{
+		op:       "refresh token",
+		method:   http.MethodPost,
+		endpoint: escapedPath(d.GetOpenIdConnectEndpoint(), "token"),
+		form:     form,
+		result:   &accessToken,
+	})
+	if err != nil {
+		return domain.AccessTokenResponse{}, err
+	}
+
+	return accessToken, nil
+}
+
+func (d DefaultTokenService) Logout(ctx context.Context, refreshToken string, client ClientCredentials) error {
+	form := clientForm(client)
+	form.Set("refresh_token", refreshToken)
+
+	err := newAdminClient(d.Configuration).do(ctx, "", adminRequest{
+		op:       "logout",
+		method:   http.MethodPost,
+		endpoint: escapedPath(d.GetOpenIdConnectEndpoint(), "logout"),
+		form:     form,
+	})
+	return err
+}
+
+func (d DefaultTokenService) RevokeToken(ctx context.Context, token, tokenTypeHint string, client ClientCredentials) error {
+	form := clientForm(client)
+	form.Set("token", token)
+	if tokenTypeHint != "" {
+		form.Set("token_type_hint", tokenTypeHint)
+	}
+
+	err := newAdminClient(d.Configuration).do(ctx, "", adminRequest{
+		op:       "revoke token",
+		method:   http.MethodPost,
+		endpoint: escapedPath(d.GetOpenIdConnectEndpoint(), "revoke"),
+		form:     form,
+	})
+	return err
+}
+
+// clientForm authenticates a client in the request body, public clients only send their id.
+func clientForm(client ClientCredentials) url.Values {
+	form := url.Values{}
+	form.Set("client_id", client.ClientId)
+	if client.ClientSecret != "" {
+		form.Set("client_secret", client.ClientSecret)
+	}
+	return form
+}
+
+func (d DefaultTokenService) IntrospectToken(ctx context.Context, token string) (domain.TokenClaims, bool, error) {
+	form := clientForm(d.GetClientCredentials())
+	form.Set("token", token)
+
+	var introspection struct {
+		Active bool `json:"active"`
+		keycloakClaims
+	}
+	err := newAdminClient(d.Configuration).do(ctx, "", adminRequest{
+		op:       "introspect token",
+		method:   http.MethodPost,
+		endpoint: escapedPath(d.GetOpenIdConnectEndpoint(), "token", "introspect"),
+		form:     form,
+		result:   &introspection,
+		// introspection authenticates with the service's client, not the caller's
+		serviceAccount: true,
+	})
+	if err != nil {
+		return domain.TokenClaims{}, false, err
+	}
+
+	if !introspection.Active {
+		return domain.TokenClaims{}, false, nil
+	}
+	return introspection.keycloakClaims.toDomain(), true, nil
+}
+
+func (d DefaultTokenService) GetUserInfo(ctx context.Context, accessToken string) (domain.OIDCInfo, error) {
+	var userInfo domain.OIDCInfo
+	err := newAdminClient(d.Configuration).do(ctx, accessToken, adminRequest{
+		op:       "get user info",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.GetOpenIdConnectEndpoint(), "userinfo"),
+		result:   &userInfo,
+	})
+	if err != nil {
+		return domain.OIDCInfo{}, err
+	}
+
+	return userInfo, nil
+}
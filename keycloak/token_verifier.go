@@ -0,0 +1,125 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hub1989/keycloak-grpc-service/domain"
+	"strings"
+	"time"
+)
+
+const (
+	tokenLeeway = 5 * time.Second
+	// accessTokenType is the typ claim of Keycloak access tokens; ID and
+	// refresh tokens are signed with the same keys but carry "ID" and "Refresh".
+	accessTokenType = "Bearer"
+)
+
+type TokenVerifier interface {
+	VerifyToken(ctx context.Context, rawToken string) (domain.TokenClaims, error)
+	// VerifyTokenForAudience checks the given audience instead of the configured one, none when empty.
+	VerifyTokenForAudience(ctx context.Context, rawToken, audience string) (domain.TokenClaims, error)
+}
+
+// DefaultTokenVerifier checks the signature of realm-issued access tokens
+// against the realm's cached JWKS, along with issuer, audience and expiry.
+type DefaultTokenVerifier struct {
+	Configuration
+	// Issuer defaults to the realm URL under BaseURL.
+	Issuer string
+	// Audience must be present in the token's aud claim. It is not checked when empty.
+	Audience string
+
+	keys *realmKeys
+}
+
+type keycloakClaims struct {
+	jwt.RegisteredClaims
+	Type              string `json:"typ"`
+	AuthorizedParty   string `json:"azp"`
+	SessionId         string `json:"sid"`
+	SessionState      string `json:"session_state"`
+	Scope             string `json:"scope"`
+	PreferredUsername string `json:"preferred_username"`
+	Email             string `json:"email"`
+	RealmAccess       struct {
+		Roles []string `json:"roles"`
+	} `json:"realm_access"`
+	ResourceAccess map[string]struct {
+		Roles []string `json:"roles"`
+	} `json:"resource_access"`
+}
+
+func NewDefaultTokenVerifier(configuration Configuration, issuer, audience string) *DefaultTokenVerifier {
+	if issuer == "" {
+		issuer = fmt.Sprintf("%s/realms/%s", configuration.GetBaseUrl(), configuration.GetRealm())
+	}
+
+	return &DefaultTokenVerifier{
+		Configuration: configuration,
+		Issuer:        issuer,
+		Audience:      audience,
+		keys:          newRealmKeys(configuration),
+	}
+}
+
+func (d *DefaultTokenVerifier) VerifyToken(ctx context.Context, rawToken string) (domain.TokenClaims, error) {
+	return d.VerifyTokenForAudience(ctx, rawToken, d.Audience)
+}
+
+func (d *DefaultTokenVerifier) VerifyTokenForAudience(ctx context.Context, rawToken, audience string) (domain.TokenClaims, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(d.Issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(tokenLeeway),
+	}
+	if audience != "" {
+		options = append(options, jwt.WithAudience(audience))
+	}
+
+	var claims keycloakClaims
+	_, err := jwt.ParseWithClaims(rawToken, &claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return d.keys.key(ctx, kid)
+	}, options...)
+	if err != nil {
+		return domain.TokenClaims{}, fmt.Errorf("invalid token: %w", err)
+	}
+	if claims.Type != accessTokenType {
+		return domain.TokenClaims{}, fmt.Errorf("invalid token: type %q is not an access token", claims.Type)
+	}
+
+	return claims.toDomain(), nil
+}
+
+func (c keycloakClaims) toDomain() domain.TokenClaims {
+	claims := domain.TokenClaims{
+		Subject:           c.Subject,
+		Issuer:            c.Issuer,
+		Audience:          c.Audience,
+		AuthorizedParty:   c.AuthorizedParty,
+		SessionId:         c.SessionId,
+		Scopes:            strings.Fields(c.Scope),
+		PreferredUsername: c.PreferredUsername,
+		Email:             c.Email,
+		RealmRoles:        c.RealmAccess.Roles,
+		ClientRoles:       make(map[string][]string),
+	}
+
+	if claims.SessionId == "" {
+		claims.SessionId = c.SessionState
+	}
+	if c.ExpiresAt != nil {
+		claims.ExpiresAt = c.ExpiresAt.Time
+	}
+	if c.IssuedAt != nil {
+		claims.IssuedAt = c.IssuedAt.Time
+	}
+	for client, access := range c.ResourceAccess {
+		claims.ClientRoles[client] = access.Roles
+	}
+
+	return claims
+}
@@ -0,0 +1,62 @@
+package keycloak
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestVerifyTokenRequiresAccessToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"keys":[{"kid":"kid","kty":"RSA","use":"sig","n":%q,"e":%q}]}`,
+			base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()))
+	}))
+	defer server.Close()
+
+	verifier := NewDefaultTokenVerifier(DefaultKeycloakConfiguration{BaseURL: server.URL, Realm: "test", Client: server.Client()}, "", "")
+
+	tests := []struct {
+		typ     string
+		wantErr bool
+	}{
+		{"Bearer", false},
+		{"ID", true},
+		{"Refresh", true},
+		{"", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+				"iss": server.URL + "/realms/test",
+				"sub": "user",
+				"typ": tt.typ,
+				"exp": time.Now().Add(time.Minute).Unix(),
+			})
+			token.Header["kid"] = "kid"
+			raw, err := token.SignedString(key)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = verifier.VerifyToken(context.Background(), raw)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VerifyToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
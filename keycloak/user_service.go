@@ -1,16 +1,13 @@
 package keycloak
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"github.com/hub1989/keycloak-grpc-service/domain"
-	log "github.com/sirupsen/logrus"
-	"io"
 	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -25,118 +22,67 @@ type UserService interface {
 	Authenticate(ctx context.Context, username, password string, clientId, clientSecret string) (domain.AccessTokenResponse, error)
 
 	GetAllUsers(ctx context.Context, token string) ([]domain.UserRepresentation, error)
-	GetUsersByIds(ctx context.Context, ids []string, token string) ([]domain.UserRepresentation, error)
-	GetUsersByUsernames(ctx context.Context, usernames []string, token string) ([]domain.UserRepresentation, error)
+	SearchUsers(ctx context.Context, search domain.UserSearch, token string) ([]domain.UserRepresentation, error)
+	CountUsers(ctx context.Context, search domain.UserSearch, token string) (int, error)
+	GetUsersByIds(ctx context.Context, ids []string, token string) ([]domain.UserRepresentation, []string, error)
+	GetUsersByUsernames(ctx context.Context, usernames []string, token string) ([]domain.UserRepresentation, []string, error)
 
 	SetUserPassword(ctx context.Context, password string, id string, temporary bool, token string) (bool, error)
+	GetUserCredentials(ctx context.Context, id string, token string) ([]domain.StoredCredential, error)
+	DeleteUserCredential(ctx context.Context, id, credentialId string, token string) error
+	SetCredentialLabel(ctx context.Context, id, credentialId, label string, token string) error
+	// MoveCredential places the credential after another one, or first when after is empty.
+	MoveCredential(ctx context.Context, id, credentialId, after string, token string) error
+
+	GetUserRoleMappings(ctx context.Context, id string, token string) (domain.RoleMappings, error)
+	GetUserGroups(ctx context.Context, id string, token string) ([]domain.GroupOverview, error)
+	// GetUserMemberships returns the memberships in the order of ids.
+	GetUserMemberships(ctx context.Context, ids []string, expand domain.UserExpand, token string) ([]domain.UserMemberships, error)
+
+	// GetRequiredActions lists the required actions registered in the realm.
+	GetRequiredActions(ctx context.Context, token string) ([]domain.RequiredAction, error)
+	ExecuteActionsEmail(ctx context.Context, id string, actions []string, email domain.ActionsEmail, token string) error
+	SendVerifyEmail(ctx context.Context, id string, email domain.ActionsEmail, token string) error
 }
 
+const allUsersPageSize = 500
+
 type DefaultUserService struct {
 	Configuration
+	// BatchConcurrency limits the lookups a batch call runs in parallel.
+	BatchConcurrency int
+	// MaxBatchSize caps the keys a batch call accepts.
+	MaxBatchSize int
 }
 
 func (d DefaultUserService) CreateUser(ctx context.Context, request domain.UserRepresentation, token string) error {
-	endpoint := d.GetUserEndpoint()
-
-	body, err := json.Marshal(request)
-	bodyReader := bytes.NewReader(body)
-
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bodyReader)
-
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := client.Do(req)
-
-	if err != nil {
-		log.WithError(err).Error("could not create user")
-		return err
-	}
-
-	if resp.StatusCode != 201 {
-		log.Error("could not create user: ", resp)
-		return errors.New(fmt.Sprintf("could not create user. got status: %v", resp.StatusCode))
-	}
-
-	return nil
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "create user",
+		method:   http.MethodPost,
+		endpoint: d.GetUserEndpoint(),
+		body:     request,
+	})
+	return err
 }
 
 func (d DefaultUserService) UpdateUser(ctx context.Context, request domain.UserRepresentation, token string) error {
-
-	endpoint := fmt.Sprintf("%s/%s", d.GetUserEndpoint(), request.Id)
-
-	body, err := json.Marshal(request)
-	if err != nil {
-		return err
-	}
-	bodyReader := bytes.NewReader(body)
-
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bodyReader)
-
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := client.Do(req)
-
-	if err != nil {
-		log.WithError(err).Error("could not update user")
-		return err
-	}
-
-	if resp.StatusCode != 204 {
-		log.Error("could not update user: ", resp)
-		return errors.New(fmt.Sprintf("could not update user. got status: %v", resp.StatusCode))
-	}
-
-	defer resp.Body.Close()
-	body, err = io.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "update user",
+		method:   http.MethodPut,
+		endpoint: escapedPath(d.GetUserEndpoint(), request.Id),
+		body:     request,
+	})
+	return err
 }
 
 func (d DefaultUserService) GetUserById(ctx context.Context, id string, token string) (domain.UserRepresentation, error) {
-	endpoint := fmt.Sprintf("%s/%s", d.GetUserEndpoint(), id)
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-
-	if err != nil {
-		return domain.UserRepresentation{}, err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+token)
-	res, err := client.Do(req)
-
-	if err != nil {
-		log.WithError(err).Error("could not get user")
-		return domain.UserRepresentation{}, err
-	}
-
-	if res.StatusCode != 200 {
-		return domain.UserRepresentation{}, errors.New(fmt.Sprintf("could not get user. got status: %v", res.StatusCode))
-	}
-
-	defer res.Body.Close()
-	data, err := io.ReadAll(res.Body)
-
-	if err != nil {
-		return domain.UserRepresentation{}, err
-	}
-
 	var userInfo domain.UserRepresentation
-	err = json.Unmarshal(data, &userInfo)
-
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get user",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.GetUserEndpoint(), id),
+		result:   &userInfo,
+	})
 	if err != nil {
 		return domain.UserRepresentation{}, err
 	}
@@ -145,289 +91,403 @@ func (d DefaultUserService) GetUserById(ctx context.Context, id string, token st
 }
 
 func (d DefaultUserService) DeleteUser(ctx context.Context, id string, token string) error {
-	endpoint := fmt.Sprintf("%s/%s", d.GetUserEndpoint(), id)
-
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, endpoint, nil)
-
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+token)
-	resp, err := client.Do(req)
-
-	if err != nil {
-		log.WithError(err).Error("could not delete user")
-	}
-
-	if resp.StatusCode != 204 {
-		log.Error("could not delete user: ", resp)
-		return errors.New(fmt.Sprintf("could not delete user. got status: %v", resp.StatusCode))
-	}
-
-	return nil
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "delete user",
+		method:   http.MethodDelete,
+		endpoint: escapedPath(d.GetUserEndpoint(), id),
+	})
+	return err
 }
 
 func (d DefaultUserService) Authenticate(ctx context.Context, username, password string, clientId, clientSecret string) (domain.AccessTokenResponse, error) {
+	endpoint := escapedPath(d.GetOpenIdConnectEndpoint(), "token")
 
-	endpoint := fmt.Sprintf("%s/realms/%s/protocol/openid-connect/token", d.GetBaseUrl(), d.GetRealm())
-	method := "POST"
-
-	password = url.QueryEscape(password)
-
-	requestForm := fmt.Sprintf("client_id=%s&username=%s&password=%s&grant_type=password&scope=openid profile", clientId, username, password)
+	form := url.Values{}
+	form.Set("client_id", clientId)
+	form.Set("username", username)
+	form.Set("password", password)
+	form.Set("grant_type", "password")
+	form.Set("scope", "openid profile")
 
 	if clientSecret != "" {
-		requestForm = fmt.Sprintf("%s&client_secret=%s", requestForm, clientSecret)
-	}
-	payload := strings.NewReader(requestForm)
-
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, method, endpoint, payload)
-
-	if err != nil {
-		log.WithError(err).Error("could not get access token for operation")
-		return domain.AccessTokenResponse{}, err
+		form.Set("client_secret", clientSecret)
 	}
-	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-	req.Header.Set("Accept", "application/json")
 
-	res, err := client.Do(req)
+	var accessToken domain.AccessTokenResponse
+	err := newAdminClient(d.Configuration).do(ctx, "", adminRequest{
+		op:       "authenticate user",
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		form:     form,
+		result:   &accessToken,
+	})
 	if err != nil {
-		log.WithError(err).Error("could not get access token for operation")
 		return domain.AccessTokenResponse{}, err
 	}
 
-	if res.StatusCode != 200 {
-		return domain.AccessTokenResponse{}, errors.New("could not authenticate user :" + res.Status)
-	}
-	defer res.Body.Close()
+	return accessToken, nil
+}
 
-	body, err := io.ReadAll(res.Body)
+func (d DefaultUserService) GetUserByUsername(ctx context.Context, username string, token string) (domain.UserRepresentation, error) {
+	var users []domain.UserRepresentation
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get user",
+		method:   http.MethodGet,
+		endpoint: d.GetUserEndpoint(),
+		query:    url.Values{"username": {username}, "exact": {"true"}},
+		result:   &users,
+	})
 	if err != nil {
-		log.WithError(err).Error("could not get access token for operation")
-		return domain.AccessTokenResponse{}, err
+		return domain.UserRepresentation{}, err
 	}
 
-	var accessToken domain.AccessTokenResponse
-	err = json.Unmarshal(body, &accessToken)
-	if err != nil {
-		return domain.AccessTokenResponse{}, err
+	// keycloak stores usernames in lower case
+	for _, user := range users {
+		if strings.EqualFold(user.Username, username) {
+			return user, nil
+		}
 	}
 
-	return accessToken, nil
+	return domain.UserRepresentation{}, fmt.Errorf("no user found: %w", ErrNotFound)
 }
 
-func (d DefaultUserService) GetUserByUsername(ctx context.Context, username string, token string) (domain.UserRepresentation, error) {
-	endpoint := fmt.Sprintf("%s?username=%s", d.GetUserEndpoint(), username)
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+func (d DefaultUserService) AddUserToGroup(ctx context.Context, userId, groupId, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "add user to group",
+		method:   http.MethodPut,
+		endpoint: escapedPath(d.GetUserEndpoint(), userId, "groups", groupId),
+	})
+	return err
+}
 
-	if err != nil {
-		return domain.UserRepresentation{}, err
-	}
+func (d DefaultUserService) RemoveUserFromGroup(ctx context.Context, userId, groupId, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "remove user from group",
+		method:   http.MethodDelete,
+		endpoint: escapedPath(d.GetUserEndpoint(), userId, "groups", groupId),
+	})
+	return err
+}
 
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-	res, err := client.Do(req)
+// GetAllUsers pages through every user in the realm.
+func (d DefaultUserService) GetAllUsers(ctx context.Context, token string) ([]domain.UserRepresentation, error) {
+	var users []domain.UserRepresentation
+	search := domain.UserSearch{Max: allUsersPageSize}
 
-	if err != nil {
-		log.WithError(err).Error("could not get user")
-		return domain.UserRepresentation{}, err
-	}
+	for {
+		page, err := d.SearchUsers(ctx, search, token)
+		if err != nil {
+			return nil, err
+		}
 
-	if res.StatusCode != 200 {
-		log.WithError(err).Error("could not get user")
-		return domain.UserRepresentation{}, errors.New("could not get user info -- see reason " + res.Status)
+		users = append(users, page...)
+		if len(page) < search.Max {
+			return users, nil
+		}
+		search.First += len(page)
 	}
+}
 
-	defer res.Body.Close()
-	data, err := io.ReadAll(res.Body)
-
-	if err != nil {
-		return domain.UserRepresentation{}, err
+func (d DefaultUserService) SearchUsers(ctx context.Context, search domain.UserSearch, token string) ([]domain.UserRepresentation, error) {
+	query := userSearchQuery(search)
+	query.Set("first", strconv.Itoa(search.First))
+	if search.Max > 0 {
+		query.Set("max", strconv.Itoa(search.Max))
+	}
+	if search.Exact {
+		query.Set("exact", "true")
 	}
 
 	var users []domain.UserRepresentation
-	err = json.Unmarshal(data, &users)
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "search users",
+		method:   http.MethodGet,
+		endpoint: d.GetUserEndpoint(),
+		query:    query,
+		result:   &users,
+	})
 	if err != nil {
-		return domain.UserRepresentation{}, err
+		return nil, err
 	}
 
-	if len(users) > 0 {
-		return users[0], nil
+	return users, nil
+}
+
+func (d DefaultUserService) CountUsers(ctx context.Context, search domain.UserSearch, token string) (int, error) {
+	var count int
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "count users",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.GetUserEndpoint(), "count"),
+		query:    userSearchQuery(search),
+		result:   &count,
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	return domain.UserRepresentation{}, errors.New("no user found")
+	return count, nil
 }
 
-func (d DefaultUserService) AddUserToGroup(ctx context.Context, userId, groupId, token string) error {
-	endpoint := fmt.Sprintf("%s/%s/groups/%s", d.GetUserEndpoint(), userId, groupId)
+func userSearchQuery(search domain.UserSearch) url.Values {
+	query := url.Values{}
 
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, nil)
+	setIfPresent := func(key, value string) {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	setIfPresent("search", search.Search)
+	setIfPresent("username", search.Username)
+	setIfPresent("email", search.Email)
+	setIfPresent("firstName", search.FirstName)
+	setIfPresent("lastName", search.LastName)
 
-	if err != nil {
-		return err
+	if search.Enabled != nil {
+		query.Set("enabled", strconv.FormatBool(*search.Enabled))
+	}
+	if search.EmailVerified != nil {
+		query.Set("emailVerified", strconv.FormatBool(*search.EmailVerified))
 	}
 
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := client.Do(req)
+	if len(search.Attributes) > 0 {
+		keys := make([]string, 0, len(search.Attributes))
+		for key := range search.Attributes {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
 
-	if err != nil {
-		log.WithError(err).Error("could not add user to group")
+		terms := make([]string, 0, len(keys))
+		for _, key := range keys {
+			terms = append(terms, fmt.Sprintf("%s:%s", key, search.Attributes[key]))
+		}
+		query.Set("q", strings.Join(terms, " "))
 	}
 
-	if resp.StatusCode != 201 {
-		log.Error("could not add user to group: ", resp)
-		return errors.New(fmt.Sprintf("could not create user. got status: %v", resp.StatusCode))
-	}
+	return query
+}
 
-	return nil
+// GetUsersByIds returns the users found and the ids Keycloak does not know.
+func (d DefaultUserService) GetUsersByIds(ctx context.Context, ids []string, token string) ([]domain.UserRepresentation, []string, error) {
+	return fetchUsers(ctx, ids, d.BatchConcurrency, d.MaxBatchSize, func(ctx context.Context, id string) (domain.UserRepresentation, error) {
+		return d.GetUserById(ctx, id, token)
+	})
 }
 
-func (d DefaultUserService) RemoveUserFromGroup(ctx context.Context, userId, groupId, token string) error {
-	endpoint := fmt.Sprintf("%s/%s/groups/%s", d.GetUserEndpoint(), userId, groupId)
+// GetUsersByUsernames returns the users found and the usernames Keycloak does not know.
+func (d DefaultUserService) GetUsersByUsernames(ctx context.Context, usernames []string, token string) ([]domain.UserRepresentation, []string, error) {
+	return fetchUsers(ctx, usernames, d.BatchConcurrency, d.MaxBatchSize, func(ctx context.Context, username string) (domain.UserRepresentation, error) {
+		return d.GetUserByUsername(ctx, username, token)
+	})
+}
 
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, endpoint, nil)
+func (d DefaultUserService) SetUserPassword(ctx context.Context, password string, id string, temporary bool, token string) (bool, error) {
+	credentials := domain.Credential{
+		Value:     password,
+		Type:      "password",
+		Temporary: temporary,
+	}
 
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "set user password",
+		method:   http.MethodPut,
+		endpoint: escapedPath(d.GetUserEndpoint(), id, "reset-password"),
+		body:     credentials,
+	})
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := client.Do(req)
+	return true, nil
+}
 
+func (d DefaultUserService) GetUserRoleMappings(ctx context.Context, id string, token string) (domain.RoleMappings, error) {
+	var mappings domain.RoleMappings
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get user role mappings",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.GetUserEndpoint(), id, "role-mappings"),
+		result:   &mappings,
+	})
 	if err != nil {
-		log.WithError(err).Error("could not remove user from group")
+		return domain.RoleMappings{}, err
 	}
 
-	if resp.StatusCode != 201 {
-		log.Error("could not remove user from group: ", resp)
-		return errors.New(fmt.Sprintf("could not create user. got status: %v", resp.StatusCode))
-	}
-
-	return nil
+	return mappings, nil
 }
 
-func (d DefaultUserService) GetAllUsers(ctx context.Context, token string) ([]domain.UserRepresentation, error) {
-	endpoint := d.GetUserEndpoint()
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-
+func (d DefaultUserService) GetUserGroups(ctx context.Context, id string, token string) ([]domain.GroupOverview, error) {
+	var groups []domain.GroupOverview
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get user groups",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.GetUserEndpoint(), id, "groups"),
+		result:   &groups,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-	res, err := client.Do(req)
+	return groups, nil
+}
 
-	if err != nil {
-		log.WithError(err).Error("could not get user")
-		return nil, err
-	}
+// GetUserMemberships runs the lookups for every user with at most
+// BatchConcurrency requests in flight.
+func (d DefaultUserService) GetUserMemberships(ctx context.Context, ids []string, expand domain.UserExpand, token string) ([]domain.UserMemberships, error) {
+	memberships := make([]domain.UserMemberships, len(ids))
+	for i, id := range ids {
+		memberships[i].UserId = id
+	}
+
+	var lookups []func(ctx context.Context) error
+	for i := range memberships {
+		membership := &memberships[i]
+
+		if expand.Roles {
+			lookups = append(lookups, func(ctx context.Context) error {
+				mappings, err := d.GetUserRoleMappings(ctx, membership.UserId, token)
+				if err != nil {
+					return err
+				}
+
+				for _, role := range mappings.RealmMappings {
+					membership.RealmRoles = append(membership.RealmRoles, role.Name)
+				}
+				for clientId, client := range mappings.ClientMappings {
+					if membership.ClientRoles == nil {
+						membership.ClientRoles = make(map[string][]string)
+					}
+					for _, role := range client.Mappings {
+						membership.ClientRoles[clientId] = append(membership.ClientRoles[clientId], role.Name)
+					}
+				}
+				return nil
+			})
+		}
 
-	if res.StatusCode != 200 {
-		return nil, errors.New(fmt.Sprintf("could not get users. got status: %v", res.StatusCode))
+		if expand.Groups {
+			lookups = append(lookups, func(ctx context.Context) error {
+				groups, err := d.GetUserGroups(ctx, membership.UserId, token)
+				membership.Groups = groups
+				return err
+			})
+		}
 	}
 
-	defer res.Body.Close()
-	data, err := io.ReadAll(res.Body)
-
+	err := forEach(ctx, len(lookups), d.BatchConcurrency, func(ctx context.Context, i int) error {
+		return lookups[i](ctx)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var userInfo []domain.UserRepresentation
-	err = json.Unmarshal(data, &userInfo)
-
-	return userInfo, nil
+	return memberships, nil
 }
 
-func (d DefaultUserService) GetUsersByIds(ctx context.Context, ids []string, token string) ([]domain.UserRepresentation, error) {
-	users, err := d.GetAllUsers(ctx, token)
+func (d DefaultUserService) GetRequiredActions(ctx context.Context, token string) ([]domain.RequiredAction, error) {
+	var actions []domain.RequiredAction
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get required actions",
+		method:   http.MethodGet,
+		endpoint: escapedPath(d.GetBaseUrl(), "admin", "realms", d.GetRealm(), "authentication", "required-actions"),
+		result:   &actions,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	userMap := make(map[string]domain.UserRepresentation)
+	return actions, nil
+}
 
-	for _, c := range users {
-		userMap[c.Id] = c
+func (d DefaultUserService) ExecuteActionsEmail(ctx context.Context, id string, actions []string, email domain.ActionsEmail, token string) error {
+	query := actionsEmailQuery(email)
+	if email.Lifespan > 0 {
+		query.Set("lifespan", strconv.Itoa(email.Lifespan))
 	}
 
-	var response []domain.UserRepresentation
-	for _, id := range ids {
-		user, ok := userMap[id]
-		if ok {
-			response = append(response, user)
-		}
-	}
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "send execute actions email",
+		method:   http.MethodPut,
+		endpoint: escapedPath(d.GetUserEndpoint(), id, "execute-actions-email"),
+		query:    query,
+		body:     actions,
+	})
+	return err
+}
 
-	return response, nil
+func (d DefaultUserService) SendVerifyEmail(ctx context.Context, id string, email domain.ActionsEmail, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "send verify email",
+		method:   http.MethodPut,
+		endpoint: escapedPath(d.GetUserEndpoint(), id, "send-verify-email"),
+		query:    actionsEmailQuery(email),
+	})
+	return err
 }
 
-func (d DefaultUserService) GetUsersByUsernames(ctx context.Context, usernames []string, token string) ([]domain.UserRepresentation, error) {
-	users, err := d.GetAllUsers(ctx, token)
-	if err != nil {
-		return nil, err
+func actionsEmailQuery(email domain.ActionsEmail) url.Values {
+	query := url.Values{}
+	if email.ClientId != "" {
+		query.Set("client_id", email.ClientId)
 	}
-
-	userMap := make(map[string]domain.UserRepresentation)
-
-	for _, c := range users {
-		userMap[c.Username] = c
+	if email.RedirectUri != "" {
+		query.Set("redirect_uri", email.RedirectUri)
 	}
+	return query
+}
 
-	var response []domain.UserRepresentation
-	for _, username := range usernames {
-		response = append(response, userMap[username])
+func (d DefaultUserService) GetUserCredentials(ctx context.Context, id string, token string) ([]domain.StoredCredential, error) {
+	var credentials []domain.StoredCredential
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "get user credentials",
+		method:   http.MethodGet,
+		endpoint: d.credentialsEndpoint(id),
+		result:   &credentials,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return credentials, nil
 }
 
-func (d DefaultUserService) SetUserPassword(ctx context.Context, password string, id string, temporary bool, token string) (bool, error) {
-	endpoint := fmt.Sprintf("%s/%s/reset-password", d.GetUserEndpoint(), id)
-
-	credentials := domain.Credential{
-		Value:     password,
-		Type:      "password",
-		Temporary: false,
-	}
-
-	body, err := json.Marshal(credentials)
-	bodyReader := bytes.NewReader(body)
+func (d DefaultUserService) DeleteUserCredential(ctx context.Context, id, credentialId string, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "delete user credential",
+		method:   http.MethodDelete,
+		endpoint: d.credentialEndpoint(id, credentialId),
+	})
+	return err
+}
 
-	client := d.GetClient()
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bodyReader)
+func (d DefaultUserService) SetCredentialLabel(ctx context.Context, id, credentialId, label string, token string) error {
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "set credential label",
+		method:   http.MethodPut,
+		endpoint: escapedPath(d.credentialEndpoint(id, credentialId), "userLabel"),
+		text:     label,
+	})
+	return err
+}
 
-	if err != nil {
-		return false, err
+func (d DefaultUserService) MoveCredential(ctx context.Context, id, credentialId, after string, token string) error {
+	endpoint := escapedPath(d.credentialEndpoint(id, credentialId), "moveToFirst")
+	if after != "" {
+		endpoint = escapedPath(d.credentialEndpoint(id, credentialId), "moveAfter", after)
 	}
 
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := client.Do(req)
-
-	if err != nil {
-		log.WithError(err).Error("could not update user password")
-	}
+	err := newAdminClient(d.Configuration).do(ctx, token, adminRequest{
+		op:       "move user credential",
+		method:   http.MethodPost,
+		endpoint: endpoint,
+	})
+	return err
+}
 
-	if resp.StatusCode != 204 {
-		log.Error("could not update user password: ", resp)
-		return false, errors.New(fmt.Sprintf("could not setuser password user. got status: %v", resp.StatusCode))
-	}
+func (d DefaultUserService) credentialsEndpoint(id string) string {
+	return escapedPath(d.GetUserEndpoint(), id, "credentials")
+}
 
-	return true, nil
+func (d DefaultUserService) credentialEndpoint(id, credentialId string) string {
+	return escapedPath(d.credentialsEndpoint(id), credentialId)
 }
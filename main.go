@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"github.com/hub1989/keycloak-grpc-service/grpc/auth"
 	"github.com/hub1989/keycloak-grpc-service/grpc/controller"
+	"github.com/hub1989/keycloak-grpc-service/grpc/keycloakext"
 	"github.com/hub1989/keycloak-grpc-service/grpc/logger"
 	"github.com/hub1989/keycloak-grpc-service/keycloak"
+	"github.com/hub1989/keycloak-grpc-service/metrics"
 	"github.com/hub1989/keycloak-grpc-service/otel_config"
+	"github.com/hub1989/keycloak-grpc-service/tls_config"
 	user "github.com/hub1989/keycloak-protobuf/golang/keycloak"
 	"github.com/joho/godotenv"
 	log "github.com/sirupsen/logrus"
@@ -14,10 +19,18 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 )
 
 func init() {
@@ -28,6 +41,15 @@ func init() {
 	if err != nil {
 		log.Error("Error loading .env file")
 	}
+
+	if value := os.Getenv("LOG_LEVEL"); value != "" {
+		level, err := log.ParseLevel(value)
+		if err != nil {
+			log.WithError(err).Error("invalid log level")
+		} else {
+			log.SetLevel(level)
+		}
+	}
 }
 
 func main() {
@@ -38,42 +60,143 @@ func main() {
 		panic("grpc port is not set")
 	}
 
-	tp, err := otel_config.TracerProvider(os.Getenv("TRACING_URL"))
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	tp, err := otel_config.TracerProvider(ctx, otel_config.ConfigFromEnv())
 	if err != nil {
-		log.Error(err)
+		log.WithError(err).Error("could not configure tracing, spans will not be exported")
+	}
+	if tp != nil {
+		otel.SetTracerProvider(tp)
+	}
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if metricsPort := os.Getenv("METRICS_PORT"); metricsPort != "" {
+		go serveMetrics(ctx, metricsPort)
 	}
 
-	otel.SetTracerProvider(tp)
-	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}))
+	serveErr := configureGrpc(ctx, grpcPort)
+
+	flushCtx, cancel := context.WithTimeout(context.Background(), envDuration("TRACING_FLUSH_TIMEOUT", 5*time.Second))
+	if tp != nil {
+		if err = tp.Shutdown(flushCtx); err != nil {
+			log.WithError(err).Error("could not flush traces")
+		}
+	}
+	cancel()
 
-	configureGrpc(grpcPort)
+	if serveErr != nil {
+		os.Exit(1)
+	}
 }
 
-func configureGrpc(grpcPort string) {
-	lis, _ := net.Listen("tcp", grpcPort)
-	s := grpc.NewServer(grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor(), logger.ServerLogger))
+// configureGrpc serves until ctx is done. It returns the error that stopped the server early, if any.
+func configureGrpc(ctx context.Context, grpcPort string) error {
+	lis, err := net.Listen("tcp", grpcPort)
+	if err != nil {
+		log.WithError(err).Fatal(fmt.Sprintf("could not listen on port %s", grpcPort))
+	}
 
-	httpClient := &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+	httpClient := &http.Client{
+		Transport: metrics.NewTransport(otelhttp.NewTransport(http.DefaultTransport)),
+		Timeout:   envDuration("KEYCLOAK_TIMEOUT", 15*time.Second),
+	}
 
 	configuration := keycloak.DefaultKeycloakConfiguration{
 		BaseURL: os.Getenv("KEYCLOAK_URL"),
 		Realm:   os.Getenv("KEYCLOAK_REALM"),
 		Client:  httpClient,
 	}
+
+	// shared by the authenticator and TokenService/ValidateToken, so both use one key cache
+	tokenVerifier := newTokenVerifier(configuration)
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{otelgrpc.UnaryServerInterceptor(), metrics.UnaryServerMetrics}
+	streamInterceptors := []grpc.StreamServerInterceptor{otelgrpc.StreamServerInterceptor(), metrics.StreamServerMetrics}
+
+	// the logger runs before authentication so rejected calls are logged; the authenticator reports the caller back to it
+	serverLogger := logger.ServerLogger{Redaction: logger.RedactionPolicy{Fields: envList("LOG_REDACT_FIELDS")}}
+	unaryInterceptors = append(unaryInterceptors, serverLogger.Unary)
+	streamInterceptors = append(streamInterceptors, serverLogger.Stream)
+
+	var authorizer *auth.Authorizer
+
+	if os.Getenv("GRPC_AUTH_DISABLED") == "true" {
+		log.Warn("authentication of incoming calls is disabled")
+	} else {
+		authenticator := configureAuthentication(tokenVerifier)
+		unaryInterceptors = append(unaryInterceptors, authenticator.Unary)
+		streamInterceptors = append(streamInterceptors, authenticator.Stream)
+
+		if policyFile := os.Getenv("GRPC_AUTHZ_POLICY_FILE"); policyFile != "" {
+			policy, err := auth.LoadPolicy(policyFile)
+			if err != nil {
+				log.WithError(err).Fatal("could not load authorization policy")
+			}
+			authorizer = &auth.Authorizer{Policy: policy, PublicMethods: authenticator.PublicMethods}
+		}
+	}
+
+	if authorizer != nil {
+		unaryInterceptors = append(unaryInterceptors, authorizer.Unary)
+		streamInterceptors = append(streamInterceptors, authorizer.Stream)
+	}
+
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+
+	if certFile := os.Getenv("GRPC_TLS_CERT_FILE"); certFile != "" {
+		tlsConfig, err := tls_config.ServerConfig(certFile, os.Getenv("GRPC_TLS_KEY_FILE"), os.Getenv("GRPC_TLS_CLIENT_CA_FILE"))
+		if err != nil {
+			log.WithError(err).Fatal("could not configure tls")
+		}
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else {
+		log.Warn("GRPC_TLS_CERT_FILE is not set, serving grpc in plaintext")
+	}
+
+	s := grpc.NewServer(serverOptions...)
 	clientService := keycloak.DefaultClientService{Configuration: configuration}
-	credentialService := keycloak.DefaultCredentialService{Configuration: configuration}
+	credentialService := keycloak.NewDefaultCredentialService(configuration)
 	groupService := keycloak.DefaultGroupService{Configuration: configuration}
 	roleService := keycloak.DefaultRoleService{Configuration: configuration, ClientService: clientService}
-	userService := keycloak.DefaultUserService{Configuration: configuration}
+	userService := keycloak.DefaultUserService{
+		Configuration:    configuration,
+		BatchConcurrency: envInt("KEYCLOAK_BATCH_CONCURRENCY", 10),
+		MaxBatchSize:     envInt("KEYCLOAK_BATCH_MAX_SIZE", 100),
+	}
 
-	user.RegisterUserServiceServer(s, &controller.UserController{
+	userController := &controller.UserController{
 		CredentialService: credentialService,
 		UserService:       userService,
-	})
+	}
+	user.RegisterUserServiceServer(s, userController)
+	keycloakext.RegisterUserAdminServiceServer(s, userController)
+	keycloakext.RegisterCredentialAdminServiceServer(s, userController)
 
-	user.RegisterRoleServiceServer(s, &controller.RoleController{
+	roleController := &controller.RoleController{
 		RoleService:       roleService,
 		CredentialService: credentialService,
+		ClientService:     clientService,
+	}
+	user.RegisterRoleServiceServer(s, roleController)
+	keycloakext.RegisterRoleAdminServiceServer(s, roleController)
+
+	keycloakext.RegisterSessionAdminServiceServer(s, &controller.SessionController{
+		SessionService: keycloak.DefaultSessionService{
+			Configuration: configuration,
+			Concurrency:   envInt("KEYCLOAK_BATCH_CONCURRENCY", 10),
+		},
+		CredentialService: credentialService,
+		ClientService:     clientService,
+	})
+
+	keycloakext.RegisterTokenServiceServer(s, &controller.TokenController{
+		TokenService:  keycloak.DefaultTokenService{Configuration: configuration},
+		TokenVerifier: tokenVerifier,
 	})
 
 	user.RegisterGroupServiceServer(s, &controller.GroupController{
@@ -86,11 +209,164 @@ func configureGrpc(grpcPort string) {
 		CredentialService: credentialService,
 	})
 
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+	go watchKeycloak(ctx, keycloak.DefaultHealthService{Configuration: configuration}, healthServer, s)
+
 	if os.Getenv("ENV") == "development" {
 		log.Info("in development environment, reflection is enabled")
 		reflection.Register(s)
 	}
 
-	log.Info(fmt.Sprintf("running grpc on port %s", grpcPort))
-	log.Fatal(s.Serve(lis))
+	if authorizer != nil {
+		if err := authorizer.Validate(s.GetServiceInfo()); err != nil {
+			log.WithError(err).Fatal("invalid authorization policy")
+		}
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Info(fmt.Sprintf("running grpc on port %s", grpcPort))
+		serveErr <- s.Serve(lis)
+	}()
+
+	select {
+	case err = <-serveErr:
+		// returned rather than fatal, so main still flushes the spans of the calls served so far
+		log.WithError(err).Error("grpc server stopped")
+		credentialService.Close()
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Info("shutting down, draining in-flight requests")
+	healthServer.Shutdown()
+	stopGrpc(s, envDuration("GRPC_SHUTDOWN_TIMEOUT", 20*time.Second))
+	credentialService.Close()
+	return nil
+}
+
+func serveMetrics(ctx context.Context, metricsPort string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	server := &http.Server{Addr: metricsPort, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	log.Info(fmt.Sprintf("serving metrics on port %s", metricsPort))
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.WithError(err).Error("metrics server stopped")
+	}
+}
+
+// stopGrpc waits for in-flight requests to finish, and cancels whatever is still running after timeout.
+func stopGrpc(s *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		log.Warn("graceful shutdown timed out, closing remaining connections")
+		s.Stop()
+	}
+}
+
+// readinessService is the health service name that reports whether Keycloak is reachable.
+// The overall status ("") and the per-service statuses stay SERVING while the process
+// is up, so a liveness probe does not restart the service during a Keycloak outage.
+const readinessService = "readiness"
+
+// watchKeycloak reports readinessService as serving only while Keycloak's token endpoint is reachable.
+func watchKeycloak(ctx context.Context, healthService keycloak.HealthService, healthServer *health.Server, s *grpc.Server) {
+	for name := range s.GetServiceInfo() {
+		healthServer.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+
+	interval := envDuration("HEALTH_CHECK_INTERVAL", 10*time.Second)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, interval)
+		err := healthService.CheckTokenEndpoint(checkCtx)
+		cancel()
+
+		servingStatus := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			log.WithError(err).Warn("keycloak is not reachable, reporting not serving")
+			servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+		healthServer.SetServingStatus(readinessService, servingStatus)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func newTokenVerifier(configuration keycloak.Configuration) *keycloak.DefaultTokenVerifier {
+	audience := os.Getenv("GRPC_AUTH_AUDIENCE")
+	if audience == "" {
+		audience = configuration.GetClientCredentials().ClientId
+	}
+	return keycloak.NewDefaultTokenVerifier(configuration, os.Getenv("KEYCLOAK_ISSUER"), audience)
+}
+
+func configureAuthentication(tokenVerifier keycloak.TokenVerifier) auth.Authenticator {
+	publicMethods := []string{
+		"/grpc.health.v1.Health/",
+		"/grpc.reflection.v1.ServerReflection/",
+		"/grpc.reflection.v1alpha.ServerReflection/",
+		"/keycloak.UserService/Authenticate",
+		"/keycloak.ext.TokenService/RefreshToken",
+		"/keycloak.ext.TokenService/Logout",
+		"/keycloak.ext.TokenService/RevokeToken",
+		"/keycloak.ext.TokenService/UserInfo",
+	}
+	publicMethods = append(publicMethods, envList("GRPC_AUTH_PUBLIC_METHODS")...)
+
+	return auth.Authenticator{
+		TokenVerifier: tokenVerifier,
+		PublicMethods: publicMethods,
+	}
+}
+
+func envInt(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+func envDuration(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+// envList reads a comma separated list, skipping empty entries.
+func envList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
 }
@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"strings"
+	"time"
+)
+
+func UnaryServerMetrics(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observeGrpc(info.FullMethod, start, err)
+	return resp, err
+}
+
+func StreamServerMetrics(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	observeGrpc(info.FullMethod, start, err)
+	return err
+}
+
+func observeGrpc(fullMethod string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	grpcHandled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	grpcHandlingSeconds.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+}
+
+func splitMethod(fullMethod string) (string, string) {
+	service, method, found := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !found {
+		return "unknown", "unknown"
+	}
+	return service, method
+}
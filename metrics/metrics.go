@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+)
+
+var (
+	grpcHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "Number of gRPC calls completed by the server, by method and status code.",
+	}, []string{"grpc_service", "grpc_method", "grpc_code"})
+
+	grpcHandlingSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Time taken by the server to handle gRPC calls.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"grpc_service", "grpc_method"})
+
+	upstreamRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keycloak_upstream_requests_total",
+		Help: "Number of requests sent to Keycloak, by operation, HTTP method and response status.",
+	}, []string{"operation", "method", "status"})
+
+	upstreamSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "keycloak_upstream_request_duration_seconds",
+		Help:    "Time taken by Keycloak to answer requests.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation", "method"})
+
+	tokenRefreshes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keycloak_token_refreshes_total",
+		Help: "Number of service-account token refreshes, by grant type.",
+	}, []string{"grant_type"})
+
+	tokenRefreshFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keycloak_token_refresh_failures_total",
+		Help: "Number of failed service-account token refreshes, by grant type.",
+	}, []string{"grant_type"})
+
+	cacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_requests_total",
+		Help: "Number of cache lookups, by cache and result (hit or miss).",
+	}, []string{"cache", "result"})
+)
+
+// Handler serves the metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+func TokenRefreshed(grantType string, err error) {
+	tokenRefreshes.WithLabelValues(grantType).Inc()
+	if err != nil {
+		tokenRefreshFailures.WithLabelValues(grantType).Inc()
+	}
+}
+
+func CacheLookup(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	cacheRequests.WithLabelValues(cache, result).Inc()
+}
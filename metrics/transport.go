@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type operationKey struct{}
+
+// WithOperation names the Keycloak call made with ctx, e.g. "create user",
+// so upstream metrics are grouped by operation rather than by raw URL.
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+type transport struct {
+	next http.RoundTripper
+}
+
+// NewTransport records the count, status and latency of the requests sent through next.
+func NewTransport(next http.RoundTripper) http.RoundTripper {
+	return transport{next: next}
+}
+
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	operation, ok := req.Context().Value(operationKey{}).(string)
+	if !ok {
+		operation = "unknown"
+	}
+
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+	upstreamSeconds.WithLabelValues(operation, req.Method).Observe(time.Since(start).Seconds())
+
+	statusLabel := "error"
+	if err == nil {
+		statusLabel = strconv.Itoa(res.StatusCode)
+	}
+	upstreamRequests.WithLabelValues(operation, req.Method, statusLabel).Inc()
+
+	return res, err
+}
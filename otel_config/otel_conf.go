@@ -1,26 +1,171 @@
 package otel_config
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/jaeger"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/sdk/resource"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
-	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
 	"os"
+	"runtime/debug"
+	"strings"
 )
 
-func TracerProvider(url string) (*tracesdk.TracerProvider, error) {
-	exp, err := jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(url)))
+const defaultServiceName = "user-service"
+
+// OTLP transport protocols understood by OTEL_EXPORTER_OTLP_PROTOCOL.
+const (
+	ProtocolGRPC         = "grpc"
+	ProtocolHTTPProtobuf = "http/protobuf"
+)
+
+// Exporters understood by OTEL_TRACES_EXPORTER.
+const (
+	ExporterOTLP    = "otlp"
+	ExporterJaeger  = "jaeger"
+	ExporterConsole = "console"
+	ExporterNone    = "none"
+)
+
+// Config selects where spans are sent. Empty fields fall back to the standard
+// OTEL_* environment variables, see ConfigFromEnv.
+type Config struct {
+	// Exporter is one of otlp, jaeger, console or none.
+	Exporter string
+	// JaegerEndpoint is the collector endpoint used by the jaeger exporter.
+	JaegerEndpoint string
+	// OTLPProtocol is grpc or http/protobuf. The OTLP exporters read their
+	// endpoint, headers, timeout and TLS settings from OTEL_EXPORTER_OTLP_*.
+	OTLPProtocol string
+}
+
+// ConfigFromEnv reads OTEL_SDK_DISABLED and OTEL_TRACES_EXPORTER. Without an
+// explicit exporter, spans go to OTLP when an OTEL_EXPORTER_OTLP endpoint is
+// set, to the jaeger exporter when only TRACING_URL is set, and nowhere otherwise.
+// The jaeger exporter reads its endpoint from TRACING_URL, falling back to
+// OTEL_EXPORTER_JAEGER_ENDPOINT.
+func ConfigFromEnv() Config {
+	exporter := strings.ToLower(strings.TrimSpace(os.Getenv("OTEL_TRACES_EXPORTER")))
+	switch {
+	case strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true"):
+		exporter = ExporterNone
+	case exporter != "":
+	case os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != "", os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "":
+		exporter = ExporterOTLP
+	case os.Getenv("TRACING_URL") != "":
+		exporter = ExporterJaeger
+	default:
+		exporter = ExporterNone
+	}
+
+	protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+	if protocol == "" {
+		protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+
+	return Config{
+		Exporter:       exporter,
+		JaegerEndpoint: os.Getenv("TRACING_URL"),
+		OTLPProtocol:   protocol,
+	}
+}
+
+// TracerProvider builds a provider exporting spans as configured. It returns a
+// nil provider when tracing is turned off, in which case the global no-op
+// provider should be left in place.
+//
+// Sampling follows OTEL_TRACES_SAMPLER and OTEL_TRACES_SAMPLER_ARG, e.g.
+// parentbased_traceidratio with a ratio of 0.1, and defaults to parentbased_always_on.
+func TracerProvider(ctx context.Context, config Config) (*tracesdk.TracerProvider, error) {
+	exp, err := exporter(ctx, config)
+	if err != nil || exp == nil {
+		return nil, err
+	}
+
+	res, err := newResource(ctx)
 	if err != nil {
 		return nil, err
 	}
-	tp := tracesdk.NewTracerProvider(
+
+	// export failures are reported here; the batcher drops the spans instead of blocking requests
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		log.WithError(err).Warn("opentelemetry error")
+	}))
+
+	return tracesdk.NewTracerProvider(
 		tracesdk.WithBatcher(exp),
-		tracesdk.WithResource(resource.NewWithAttributes(
-			semconv.SchemaURL,
-			semconv.ServiceNameKey.String("user-service"),
-			attribute.String("environment", os.Getenv("ENV")),
-		)),
+		tracesdk.WithResource(res),
+	), nil
+}
+
+func exporter(ctx context.Context, config Config) (tracesdk.SpanExporter, error) {
+	switch config.Exporter {
+	case ExporterNone, "":
+		return nil, nil
+	case ExporterConsole:
+		return stdouttrace.New()
+	case ExporterJaeger:
+		var options []jaeger.CollectorEndpointOption
+		if config.JaegerEndpoint != "" {
+			options = append(options, jaeger.WithEndpoint(config.JaegerEndpoint))
+		}
+		return jaeger.New(jaeger.WithCollectorEndpoint(options...))
+	case ExporterOTLP:
+		// the collector is only contacted on export, so a missing collector does not stop the service
+		switch config.OTLPProtocol {
+		case ProtocolHTTPProtobuf, "":
+			return otlptracehttp.New(ctx)
+		case ProtocolGRPC:
+			return otlptracegrpc.New(ctx)
+		}
+		return nil, fmt.Errorf("unsupported otlp protocol %q", config.OTLPProtocol)
+	}
+	return nil, fmt.Errorf("unsupported traces exporter %q", config.Exporter)
+}
+
+// newResource describes this process. OTEL_SERVICE_NAME and
+// OTEL_RESOURCE_ATTRIBUTES override the detected values.
+func newResource(ctx context.Context) (*resource.Resource, error) {
+	attributes := []attribute.KeyValue{
+		semconv.ServiceName(defaultServiceName),
+		attribute.String("environment", os.Getenv("ENV")),
+	}
+	if name := os.Getenv("JAEGER_SERVICE_NAME"); name != "" {
+		attributes[0] = semconv.ServiceName(name)
+	}
+	if version := serviceVersion(); version != "" {
+		attributes = append(attributes, semconv.ServiceVersion(version))
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attributes...),
+		resource.WithTelemetrySDK(),
+		resource.WithHost(),
+		resource.WithOS(),
+		resource.WithProcessRuntimeName(),
+		resource.WithProcessRuntimeVersion(),
+		resource.WithContainer(),
+		resource.WithFromEnv(),
 	)
-	return tp, nil
+	if errors.Is(err, resource.ErrPartialResource) {
+		log.WithError(err).Warn("could not detect every trace resource attribute")
+		return res, nil
+	}
+	return res, err
+}
+
+func serviceVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok || info.Main.Version == "(devel)" {
+		return ""
+	}
+	return info.Main.Version
 }
@@ -0,0 +1,30 @@
+package otel_config
+
+import "testing"
+
+func TestConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{"nothing configured", nil, ExporterNone},
+		{"tracing url", map[string]string{"TRACING_URL": "http://jaeger:14268/api/traces"}, ExporterJaeger},
+		{"otlp endpoint", map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4318"}, ExporterOTLP},
+		{"otlp traces endpoint", map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://collector:4318/v1/traces"}, ExporterOTLP},
+		{"otlp endpoint and tracing url", map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4318", "TRACING_URL": "http://jaeger:14268/api/traces"}, ExporterOTLP},
+		{"explicit exporter", map[string]string{"OTEL_TRACES_EXPORTER": "console", "TRACING_URL": "http://jaeger:14268/api/traces"}, ExporterConsole},
+		{"sdk disabled", map[string]string{"OTEL_SDK_DISABLED": "true", "OTEL_TRACES_EXPORTER": "otlp"}, ExporterNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"OTEL_SDK_DISABLED", "OTEL_TRACES_EXPORTER", "TRACING_URL", "OTEL_EXPORTER_OTLP_ENDPOINT", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"} {
+				t.Setenv(key, tt.env[key])
+			}
+
+			if got := ConfigFromEnv().Exporter; got != tt.want {
+				t.Errorf("exporter = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
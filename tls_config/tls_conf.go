@@ -0,0 +1,158 @@
+package tls_config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"os"
+	"sync"
+	"time"
+)
+
+const reloadCheckInterval = 10 * time.Second
+
+// certificateReloader serves the certificate, key and client CA bundle from
+// disk, and picks up rotated files (e.g. by cert-manager) without a restart.
+// Files are checked for changes at most once per reloadCheckInterval, during
+// a handshake.
+type certificateReloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+
+	mu          sync.Mutex
+	certificate *tls.Certificate
+	clientCAs   *x509.CertPool
+	modTimes    []time.Time
+	lastChecked time.Time
+}
+
+// ServerConfig returns a TLS config for the gRPC listener. When clientCAFile
+// is set, clients must present a certificate signed by one of its CAs.
+func ServerConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("both a certificate and a key file are required")
+	}
+
+	reloader := &certificateReloader{certFile: certFile, keyFile: keyFile, clientCAFile: clientCAFile}
+	if err := reloader.load(); err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// gRPC clients require h2 to be negotiated over ALPN
+		NextProtos:     []string{"h2"},
+		GetCertificate: reloader.getCertificate,
+	}
+
+	if clientCAFile != "" {
+		// ClientCAs cannot be swapped after the listener starts, so every handshake
+		// gets a copy of the base config with the current pool.
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		base := config.Clone()
+		config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			_, clientCAs := reloader.current()
+			clientConfig := base.Clone()
+			clientConfig.ClientCAs = clientCAs
+			return clientConfig, nil
+		}
+	}
+
+	return config, nil
+}
+
+func (c *certificateReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	certificate, _ := c.current()
+	return certificate, nil
+}
+
+// current returns the certificate and client CA pool, reloading them first when the files changed.
+func (c *certificateReloader) current() (*tls.Certificate, *x509.CertPool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Since(c.lastChecked) > reloadCheckInterval {
+		c.lastChecked = time.Now()
+		if c.changed() {
+			if err := c.loadLocked(); err != nil {
+				// keep serving the previous certificate until the rotation is complete
+				log.WithError(err).Error("could not reload tls certificates")
+			} else {
+				log.Info("reloaded tls certificates")
+			}
+		}
+	}
+
+	return c.certificate, c.clientCAs
+}
+
+func (c *certificateReloader) load() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.loadLocked()
+}
+
+func (c *certificateReloader) loadLocked() error {
+	modTimes, err := c.currentModTimes()
+	if err != nil {
+		return err
+	}
+
+	certificate, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return fmt.Errorf("could not load tls key pair: %w", err)
+	}
+
+	var pool *x509.CertPool
+	if c.clientCAFile != "" {
+		pem, err := os.ReadFile(c.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("could not read client ca file: %w", err)
+		}
+
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in client ca file %s", c.clientCAFile)
+		}
+	}
+
+	c.certificate = &certificate
+	c.clientCAs = pool
+	c.modTimes = modTimes
+	return nil
+}
+
+func (c *certificateReloader) changed() bool {
+	modTimes, err := c.currentModTimes()
+	if err != nil {
+		log.WithError(err).Warn("could not check tls files for changes")
+		return false
+	}
+
+	for i := range modTimes {
+		if !modTimes[i].Equal(c.modTimes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *certificateReloader) currentModTimes() ([]time.Time, error) {
+	files := []string{c.certFile, c.keyFile}
+	if c.clientCAFile != "" {
+		files = append(files, c.clientCAFile)
+	}
+
+	modTimes := make([]time.Time, 0, len(files))
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+	return modTimes, nil
+}
@@ -0,0 +1,156 @@
+package tls_config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"google.golang.org/grpc/credentials"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCertificate writes a self-signed certificate and its key, and returns them as a tls.Certificate.
+func writeCertificate(t *testing.T, dir, name string) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	if err = os.WriteFile(filepath.Join(dir, name+".pem"), certPem, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, name+"-key.pem"), keyPem, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	certificate, err := tls.X509KeyPair(certPem, keyPem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return certificate
+}
+
+// handshake runs a gRPC server handshake with config against a client dialing with clientConfig.
+func handshake(t *testing.T, config, clientConfig *tls.Config) (tls.ConnectionState, error) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		_, _, err = credentials.NewTLS(config).ServerHandshake(conn)
+		serverErr <- err
+	}()
+
+	conn, err := tls.Dial("tcp", lis.Addr().String(), clientConfig)
+	if err != nil {
+		return tls.ConnectionState{}, err
+	}
+	defer conn.Close()
+	// TLS 1.3 reports a rejected client certificate only on the first read
+	_ = conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+	_, _ = conn.Read(make([]byte, 1))
+
+	if err = <-serverErr; err != nil {
+		return tls.ConnectionState{}, err
+	}
+	return conn.ConnectionState(), nil
+}
+
+func TestServerConfigNegotiatesH2(t *testing.T) {
+	dir := t.TempDir()
+	server := writeCertificate(t, dir, "server")
+	client := writeCertificate(t, dir, "client")
+
+	leaf, err := x509.ParseCertificate(server.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(leaf)
+
+	tests := []struct {
+		name         string
+		clientCAFile string
+	}{
+		{"tls", ""},
+		{"mtls", filepath.Join(dir, "client.pem")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := ServerConfig(filepath.Join(dir, "server.pem"), filepath.Join(dir, "server-key.pem"), tt.clientCAFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			state, err := handshake(t, config, &tls.Config{
+				RootCAs:      roots,
+				ServerName:   "localhost",
+				NextProtos:   []string{"h2"},
+				Certificates: []tls.Certificate{client},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if state.NegotiatedProtocol != "h2" {
+				t.Errorf("negotiated protocol = %q, want h2", state.NegotiatedProtocol)
+			}
+		})
+	}
+}
+
+func TestServerConfigRequiresClientCertificate(t *testing.T) {
+	dir := t.TempDir()
+	writeCertificate(t, dir, "server")
+	writeCertificate(t, dir, "client")
+
+	config, err := ServerConfig(filepath.Join(dir, "server.pem"), filepath.Join(dir, "server-key.pem"), filepath.Join(dir, "client.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = handshake(t, config, &tls.Config{InsecureSkipVerify: true, NextProtos: []string{"h2"}}); err == nil {
+		t.Fatal("handshake without a client certificate succeeded")
+	}
+}